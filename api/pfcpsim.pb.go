@@ -12,6 +12,7 @@ package api
 import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -32,128 +33,348 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
-type CreateSessionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// FARAction is the action applied by a FAR.
+type FARAction int32
 
-	// count represents the number of session
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	// baseID is used to create incremental IDs for PDRs, FARs, QERs
-	BaseID        int32    `protobuf:"varint,2,opt,name=baseID,proto3" json:"baseID,omitempty"`
-	NodeBAddress  string   `protobuf:"bytes,3,opt,name=nodeBAddress,proto3" json:"nodeBAddress,omitempty"`
-	UeAddressPool string   `protobuf:"bytes,4,opt,name=ueAddressPool,proto3" json:"ueAddressPool,omitempty"`
-	AppFilters    []string `protobuf:"bytes,5,rep,name=appFilters,proto3" json:"appFilters,omitempty"`
-	Qfi           int32    `protobuf:"varint,6,opt,name=qfi,proto3" json:"qfi,omitempty"` // Should be uint8
-}
+const (
+	FARAction_ACTION_UNSPECIFIED FARAction = 0
+	FARAction_FORWARD            FARAction = 1
+	FARAction_DROP               FARAction = 2
+	FARAction_BUFFER             FARAction = 3
+)
 
-func (x *CreateSessionRequest) Reset() {
-	*x = CreateSessionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pfcpsim_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for FARAction.
+var (
+	FARAction_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "FORWARD",
+		2: "DROP",
+		3: "BUFFER",
 	}
+	FARAction_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"FORWARD":            1,
+		"DROP":               2,
+		"BUFFER":             3,
+	}
+)
+
+func (x FARAction) Enum() *FARAction {
+	p := new(FARAction)
+	*p = x
+	return p
 }
 
-func (x *CreateSessionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x FARAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*CreateSessionRequest) ProtoMessage() {}
+func (FARAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_pfcpsim_proto_enumTypes[0].Descriptor()
+}
 
-func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfcpsim_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (FARAction) Type() protoreflect.EnumType {
+	return &file_pfcpsim_proto_enumTypes[0]
 }
 
-// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
-func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+func (x FARAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FARAction.Descriptor instead.
+func (FARAction) EnumDescriptor() ([]byte, []int) {
 	return file_pfcpsim_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *CreateSessionRequest) GetCount() int32 {
-	if x != nil {
-		return x.Count
+// HoldTimeDistribution is the distribution of the session hold times.
+type HoldTimeDistribution int32
+
+const (
+	// FIXED holds every session for the same time.
+	HoldTimeDistribution_FIXED HoldTimeDistribution = 0
+	// EXPONENTIAL holds each session for an exponentially-distributed time, whose mean is the hold time.
+	HoldTimeDistribution_EXPONENTIAL HoldTimeDistribution = 1
+)
+
+// Enum value maps for HoldTimeDistribution.
+var (
+	HoldTimeDistribution_name = map[int32]string{
+		0: "FIXED",
+		1: "EXPONENTIAL",
 	}
-	return 0
+	HoldTimeDistribution_value = map[string]int32{
+		"FIXED":       0,
+		"EXPONENTIAL": 1,
+	}
+)
+
+func (x HoldTimeDistribution) Enum() *HoldTimeDistribution {
+	p := new(HoldTimeDistribution)
+	*p = x
+	return p
 }
 
-func (x *CreateSessionRequest) GetBaseID() int32 {
-	if x != nil {
-		return x.BaseID
-	}
-	return 0
+func (x HoldTimeDistribution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CreateSessionRequest) GetNodeBAddress() string {
-	if x != nil {
-		return x.NodeBAddress
+func (HoldTimeDistribution) Descriptor() protoreflect.EnumDescriptor {
+	return file_pfcpsim_proto_enumTypes[1].Descriptor()
+}
+
+func (HoldTimeDistribution) Type() protoreflect.EnumType {
+	return &file_pfcpsim_proto_enumTypes[1]
+}
+
+func (x HoldTimeDistribution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HoldTimeDistribution.Descriptor instead.
+func (HoldTimeDistribution) EnumDescriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{1}
+}
+
+// BitRateUnit is the unit of the bit rates provided in requests.
+type BitRateUnit int32
+
+const (
+	BitRateUnit_KBPS BitRateUnit = 0
+	BitRateUnit_BPS  BitRateUnit = 1
+	BitRateUnit_MBPS BitRateUnit = 2
+)
+
+// Enum value maps for BitRateUnit.
+var (
+	BitRateUnit_name = map[int32]string{
+		0: "KBPS",
+		1: "BPS",
+		2: "MBPS",
 	}
-	return ""
+	BitRateUnit_value = map[string]int32{
+		"KBPS": 0,
+		"BPS":  1,
+		"MBPS": 2,
+	}
+)
+
+func (x BitRateUnit) Enum() *BitRateUnit {
+	p := new(BitRateUnit)
+	*p = x
+	return p
 }
 
-func (x *CreateSessionRequest) GetUeAddressPool() string {
-	if x != nil {
-		return x.UeAddressPool
+func (x BitRateUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BitRateUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_pfcpsim_proto_enumTypes[2].Descriptor()
+}
+
+func (BitRateUnit) Type() protoreflect.EnumType {
+	return &file_pfcpsim_proto_enumTypes[2]
+}
+
+func (x BitRateUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BitRateUnit.Descriptor instead.
+func (BitRateUnit) EnumDescriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{2}
+}
+
+// DuplicateUEAddressPolicy defines how the sessions whose UE address is already used are handled.
+type DuplicateUEAddressPolicy int32
+
+const (
+	// ALLOW_DUPLICATES creates the sessions without checking their UE addresses.
+	DuplicateUEAddressPolicy_ALLOW_DUPLICATES DuplicateUEAddressPolicy = 0
+	// REJECT_DUPLICATES fails the creation with ALREADY_EXISTS at the first duplicate UE address.
+	DuplicateUEAddressPolicy_REJECT_DUPLICATES DuplicateUEAddressPolicy = 1
+	// SKIP_DUPLICATES does not create the sessions whose UE address is a duplicate.
+	DuplicateUEAddressPolicy_SKIP_DUPLICATES DuplicateUEAddressPolicy = 2
+)
+
+// Enum value maps for DuplicateUEAddressPolicy.
+var (
+	DuplicateUEAddressPolicy_name = map[int32]string{
+		0: "ALLOW_DUPLICATES",
+		1: "REJECT_DUPLICATES",
+		2: "SKIP_DUPLICATES",
 	}
-	return ""
+	DuplicateUEAddressPolicy_value = map[string]int32{
+		"ALLOW_DUPLICATES":  0,
+		"REJECT_DUPLICATES": 1,
+		"SKIP_DUPLICATES":   2,
+	}
+)
+
+func (x DuplicateUEAddressPolicy) Enum() *DuplicateUEAddressPolicy {
+	p := new(DuplicateUEAddressPolicy)
+	*p = x
+	return p
 }
 
-func (x *CreateSessionRequest) GetAppFilters() []string {
-	if x != nil {
-		return x.AppFilters
+func (x DuplicateUEAddressPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DuplicateUEAddressPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_pfcpsim_proto_enumTypes[3].Descriptor()
+}
+
+func (DuplicateUEAddressPolicy) Type() protoreflect.EnumType {
+	return &file_pfcpsim_proto_enumTypes[3]
+}
+
+func (x DuplicateUEAddressPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DuplicateUEAddressPolicy.Descriptor instead.
+func (DuplicateUEAddressPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{3}
+}
+
+// Strictness defines how aggressively requests are validated and how non-fatal responses from the UPF are handled.
+type Strictness int32
+
+const (
+	// NORMAL validates requests and aborts on any UPF rejection.
+	Strictness_NORMAL Strictness = 0
+	// LENIENT sends requests best-effort and tolerates non-fatal UPF rejections.
+	Strictness_LENIENT Strictness = 1
+	// STRICT rejects any borderline request.
+	Strictness_STRICT Strictness = 2
+)
+
+// Enum value maps for Strictness.
+var (
+	Strictness_name = map[int32]string{
+		0: "NORMAL",
+		1: "LENIENT",
+		2: "STRICT",
 	}
-	return nil
+	Strictness_value = map[string]int32{
+		"NORMAL":  0,
+		"LENIENT": 1,
+		"STRICT":  2,
+	}
+)
+
+func (x Strictness) Enum() *Strictness {
+	p := new(Strictness)
+	*p = x
+	return p
 }
 
-func (x *CreateSessionRequest) GetQfi() int32 {
-	if x != nil {
-		return x.Qfi
+func (x Strictness) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Strictness) Descriptor() protoreflect.EnumDescriptor {
+	return file_pfcpsim_proto_enumTypes[4].Descriptor()
+}
+
+func (Strictness) Type() protoreflect.EnumType {
+	return &file_pfcpsim_proto_enumTypes[4]
+}
+
+func (x Strictness) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Strictness.Descriptor instead.
+func (Strictness) EnumDescriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{4}
+}
+
+// NodeType is the CP function the simulator acts as, which drives the CP Function Features advertised in the association.
+type NodeType int32
+
+const (
+	// NODE_TYPE_UNSPECIFIED advertises no CP Function Features.
+	NodeType_NODE_TYPE_UNSPECIFIED NodeType = 0
+	NodeType_SMF                   NodeType = 1
+	NodeType_SGW_C                 NodeType = 2
+	// COMBINED is a combined SGW-C/PGW-C+SMF.
+	NodeType_COMBINED NodeType = 3
+)
+
+// Enum value maps for NodeType.
+var (
+	NodeType_name = map[int32]string{
+		0: "NODE_TYPE_UNSPECIFIED",
+		1: "SMF",
+		2: "SGW_C",
+		3: "COMBINED",
 	}
-	return 0
+	NodeType_value = map[string]int32{
+		"NODE_TYPE_UNSPECIFIED": 0,
+		"SMF":                   1,
+		"SGW_C":                 2,
+		"COMBINED":              3,
+	}
+)
+
+func (x NodeType) Enum() *NodeType {
+	p := new(NodeType)
+	*p = x
+	return p
 }
 
-type ModifySessionRequest struct {
+func (x NodeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NodeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pfcpsim_proto_enumTypes[5].Descriptor()
+}
+
+func (NodeType) Type() protoreflect.EnumType {
+	return &file_pfcpsim_proto_enumTypes[5]
+}
+
+func (x NodeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NodeType.Descriptor instead.
+func (NodeType) EnumDescriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{5}
+}
+
+// URRSpec describes the URR created for each session.
+type URRSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// count represents the number of session
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	// baseID is used to create incremental IDs for PDRs, FARs, QERs
-	BaseID        int32    `protobuf:"varint,2,opt,name=baseID,proto3" json:"baseID,omitempty"`
-	NodeBAddress  string   `protobuf:"bytes,3,opt,name=nodeBAddress,proto3" json:"nodeBAddress,omitempty"`
-	UeAddressPool string   `protobuf:"bytes,4,opt,name=ueAddressPool,proto3" json:"ueAddressPool,omitempty"`
-	BufferFlag    bool     `protobuf:"varint,5,opt,name=bufferFlag,proto3" json:"bufferFlag,omitempty"`
-	NotifyCPFlag  bool     `protobuf:"varint,6,opt,name=notifyCPFlag,proto3" json:"notifyCPFlag,omitempty"`
-	AppFilters    []string `protobuf:"bytes,7,rep,name=appFilters,proto3" json:"appFilters,omitempty"`
+	// measurement methods. At least one must be set
+	Volume   bool `protobuf:"varint,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Duration bool `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Event    bool `protobuf:"varint,3,opt,name=event,proto3" json:"event,omitempty"`
 }
 
-func (x *ModifySessionRequest) Reset() {
-	*x = ModifySessionRequest{}
+func (x *URRSpec) Reset() {
+	*x = URRSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfcpsim_proto_msgTypes[1]
+		mi := &file_pfcpsim_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ModifySessionRequest) String() string {
+func (x *URRSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifySessionRequest) ProtoMessage() {}
+func (*URRSpec) ProtoMessage() {}
 
-func (x *ModifySessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfcpsim_proto_msgTypes[1]
+func (x *URRSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -164,88 +385,61 @@ func (x *ModifySessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifySessionRequest.ProtoReflect.Descriptor instead.
-func (*ModifySessionRequest) Descriptor() ([]byte, []int) {
-	return file_pfcpsim_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *ModifySessionRequest) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-func (x *ModifySessionRequest) GetBaseID() int32 {
-	if x != nil {
-		return x.BaseID
-	}
-	return 0
-}
-
-func (x *ModifySessionRequest) GetNodeBAddress() string {
-	if x != nil {
-		return x.NodeBAddress
-	}
-	return ""
-}
-
-func (x *ModifySessionRequest) GetUeAddressPool() string {
-	if x != nil {
-		return x.UeAddressPool
-	}
-	return ""
+// Deprecated: Use URRSpec.ProtoReflect.Descriptor instead.
+func (*URRSpec) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *ModifySessionRequest) GetBufferFlag() bool {
+func (x *URRSpec) GetVolume() bool {
 	if x != nil {
-		return x.BufferFlag
+		return x.Volume
 	}
 	return false
 }
 
-func (x *ModifySessionRequest) GetNotifyCPFlag() bool {
+func (x *URRSpec) GetDuration() bool {
 	if x != nil {
-		return x.NotifyCPFlag
+		return x.Duration
 	}
 	return false
 }
 
-func (x *ModifySessionRequest) GetAppFilters() []string {
+func (x *URRSpec) GetEvent() bool {
 	if x != nil {
-		return x.AppFilters
+		return x.Event
 	}
-	return nil
+	return false
 }
 
-type ConfigureRequest struct {
+// MBSSpec describes the IP multicast traffic of 5G Multicast-Broadcast Services (MBS) sessions.
+type MBSSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the data-plane interface between UPF and gNodeB
-	UpfN3Address string `protobuf:"bytes,1,opt,name=upfN3Address,proto3" json:"upfN3Address,omitempty"`
-	// the PFCP agent server address
-	RemotePeerAddress string `protobuf:"bytes,3,opt,name=remotePeerAddress,proto3" json:"remotePeerAddress,omitempty"`
+	// multicastAddress is the IP multicast address the traffic is sent to
+	MulticastAddress string `protobuf:"bytes,1,opt,name=multicastAddress,proto3" json:"multicastAddress,omitempty"`
+	// sourceAddress, if set, is the only source of the multicast traffic
+	SourceAddress string `protobuf:"bytes,2,opt,name=sourceAddress,proto3" json:"sourceAddress,omitempty"`
 }
 
-func (x *ConfigureRequest) Reset() {
-	*x = ConfigureRequest{}
+func (x *MBSSpec) Reset() {
+	*x = MBSSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfcpsim_proto_msgTypes[2]
+		mi := &file_pfcpsim_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConfigureRequest) String() string {
+func (x *MBSSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigureRequest) ProtoMessage() {}
+func (*MBSSpec) ProtoMessage() {}
 
-func (x *ConfigureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfcpsim_proto_msgTypes[2]
+func (x *MBSSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,52 +450,53 @@ func (x *ConfigureRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigureRequest.ProtoReflect.Descriptor instead.
-func (*ConfigureRequest) Descriptor() ([]byte, []int) {
-	return file_pfcpsim_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MBSSpec.ProtoReflect.Descriptor instead.
+func (*MBSSpec) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ConfigureRequest) GetUpfN3Address() string {
+func (x *MBSSpec) GetMulticastAddress() string {
 	if x != nil {
-		return x.UpfN3Address
+		return x.MulticastAddress
 	}
 	return ""
 }
 
-func (x *ConfigureRequest) GetRemotePeerAddress() string {
+func (x *MBSSpec) GetSourceAddress() string {
 	if x != nil {
-		return x.RemotePeerAddress
+		return x.SourceAddress
 	}
 	return ""
 }
 
-type DeleteSessionRequest struct {
+// HoldTime describes how long sessions exist before being deleted.
+type HoldTime struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	// baseID is used to decide where to start deleting sessions
-	BaseID int32 `protobuf:"varint,2,opt,name=baseID,proto3" json:"baseID,omitempty"`
+	// durationMs is the hold time, or its mean, in milliseconds
+	DurationMs   uint32               `protobuf:"varint,1,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
+	Distribution HoldTimeDistribution `protobuf:"varint,2,opt,name=distribution,proto3,enum=api.HoldTimeDistribution" json:"distribution,omitempty"`
 }
 
-func (x *DeleteSessionRequest) Reset() {
-	*x = DeleteSessionRequest{}
+func (x *HoldTime) Reset() {
+	*x = HoldTime{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfcpsim_proto_msgTypes[3]
+		mi := &file_pfcpsim_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteSessionRequest) String() string {
+func (x *HoldTime) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSessionRequest) ProtoMessage() {}
+func (*HoldTime) ProtoMessage() {}
 
-func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfcpsim_proto_msgTypes[3]
+func (x *HoldTime) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,48 +507,127 @@ func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSessionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSessionRequest) Descriptor() ([]byte, []int) {
-	return file_pfcpsim_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use HoldTime.ProtoReflect.Descriptor instead.
+func (*HoldTime) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *DeleteSessionRequest) GetCount() int32 {
+func (x *HoldTime) GetDurationMs() uint32 {
 	if x != nil {
-		return x.Count
+		return x.DurationMs
 	}
 	return 0
 }
 
-func (x *DeleteSessionRequest) GetBaseID() int32 {
+func (x *HoldTime) GetDistribution() HoldTimeDistribution {
 	if x != nil {
-		return x.BaseID
+		return x.Distribution
 	}
-	return 0
+	return HoldTimeDistribution_FIXED
 }
 
-type EmptyRequest struct {
+type CreateSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// count represents the number of session
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// baseID is used to create incremental IDs for PDRs, FARs, QERs
+	BaseID        int32    `protobuf:"varint,2,opt,name=baseID,proto3" json:"baseID,omitempty"`
+	NodeBAddress  string   `protobuf:"bytes,3,opt,name=nodeBAddress,proto3" json:"nodeBAddress,omitempty"`
+	UeAddressPool string   `protobuf:"bytes,4,opt,name=ueAddressPool,proto3" json:"ueAddressPool,omitempty"`
+	AppFilters    []string `protobuf:"bytes,5,rep,name=appFilters,proto3" json:"appFilters,omitempty"`
+	Qfi           int32    `protobuf:"varint,6,opt,name=qfi,proto3" json:"qfi,omitempty"` // Should be uint8
+	// cpFSEIDAddress overrides the address advertised in the CP F-SEID (e.g. a redundant SMF).
+	// If empty, the simulator local address is used.
+	CpFSEIDAddress string `protobuf:"bytes,7,opt,name=cpFSEIDAddress,proto3" json:"cpFSEIDAddress,omitempty"`
+	// uplinkDefaultAction, if set, adds a fallback uplink PDR matching any traffic not matched by the app filters,
+	// whose FAR applies the given action.
+	UplinkDefaultAction FARAction `protobuf:"varint,8,opt,name=uplinkDefaultAction,proto3,enum=api.FARAction" json:"uplinkDefaultAction,omitempty"`
+	// downlinkDefaultAction, if set, adds a fallback downlink PDR matching any traffic not matched by the app filters,
+	// whose FAR applies the given action.
+	DownlinkDefaultAction FARAction `protobuf:"varint,9,opt,name=downlinkDefaultAction,proto3,enum=api.FARAction" json:"downlinkDefaultAction,omitempty"`
+	// urr, if set, adds a URR to each session, referenced by all the PDRs of the application filters
+	Urr *URRSpec `protobuf:"bytes,10,opt,name=urr,proto3" json:"urr,omitempty"`
+	// fiveQI, if set, is a standardized 5QI driving QFI, MBR and GBR of the session QER.
+	// Values explicitly provided through qfi and the bit rates below take precedence.
+	FiveQI int32 `protobuf:"varint,11,opt,name=fiveQI,proto3" json:"fiveQI,omitempty"`
+	// session QER bit rates, expressed in bitRateUnit. Zero means not set
+	UplinkMBR   uint64 `protobuf:"varint,12,opt,name=uplinkMBR,proto3" json:"uplinkMBR,omitempty"`
+	DownlinkMBR uint64 `protobuf:"varint,13,opt,name=downlinkMBR,proto3" json:"downlinkMBR,omitempty"`
+	UplinkGBR   uint64 `protobuf:"varint,14,opt,name=uplinkGBR,proto3" json:"uplinkGBR,omitempty"`
+	DownlinkGBR uint64 `protobuf:"varint,15,opt,name=downlinkGBR,proto3" json:"downlinkGBR,omitempty"`
+	// ueIPv6PrefixLength, if set, makes each session delegate an IPv6 prefix of this length to the UE,
+	// allocated from ueAddressPool, instead of a single address. ueAddressPool must be an IPv6 pool.
+	UeIPv6PrefixLength int32 `protobuf:"varint,16,opt,name=ueIPv6PrefixLength,proto3" json:"ueIPv6PrefixLength,omitempty"`
+	// sessionQerID, if set, is the ID of the session QER referenced by all the PDRs, instead of 0.
+	// It must not collide with the IDs of the application QERs.
+	SessionQerID uint32 `protobuf:"varint,17,opt,name=sessionQerID,proto3" json:"sessionQerID,omitempty"`
+	// predefinedRules are the names of the rules predefined in the UPF, activated by the PDRs of the application filters
+	PredefinedRules []string `protobuf:"bytes,18,rep,name=predefinedRules,proto3" json:"predefinedRules,omitempty"`
+	// bitRateUnit is the unit of the session QER bit rates. They are converted to kbps, the unit of MBR and GBR IEs.
+	BitRateUnit BitRateUnit `protobuf:"varint,19,opt,name=bitRateUnit,proto3,enum=api.BitRateUnit" json:"bitRateUnit,omitempty"`
+	// ruleIDOffset is added to the IDs of all the PDRs, FARs, QERs and URRs of the sessions, including the session QER,
+	// to carve out the simulator ID space on UPFs shared with other CP functions.
+	RuleIDOffset uint32 `protobuf:"varint,20,opt,name=ruleIDOffset,proto3" json:"ruleIDOffset,omitempty"`
+	// holdTime, if set, makes each session be deleted once its hold time, measured from its creation, has elapsed.
+	HoldTime *HoldTime `protobuf:"bytes,21,opt,name=holdTime,proto3" json:"holdTime,omitempty"`
+	// initialDownlinkAction, if DROP or BUFFER, is the action of the downlink FARs of the application filters until
+	// ModifySession provides the nodeB address, as the downlink path is not known upon establishment.
+	// Otherwise, the downlink FARs forward traffic to nodeBAddress from the start.
+	InitialDownlinkAction FARAction `protobuf:"varint,22,opt,name=initialDownlinkAction,proto3,enum=api.FARAction" json:"initialDownlinkAction,omitempty"`
+	// mbs, if set, makes the sessions MBS sessions: the downlink PDRs of the application filters match the IP multicast traffic
+	// through the IP Multicast Addressing Info IE.
+	Mbs *MBSSpec `protobuf:"bytes,23,opt,name=mbs,proto3" json:"mbs,omitempty"`
+	// trafficEndpoint, if true, creates a single Traffic Endpoint per session, carrying both the uplink F-TEID and the UE IP address,
+	// referenced by both the uplink and downlink PDRs.
+	TrafficEndpoint bool `protobuf:"varint,24,opt,name=trafficEndpoint,proto3" json:"trafficEndpoint,omitempty"`
+	// trafficSteering, if set, steers the traffic of the application filters through service function chains
+	TrafficSteering *TrafficSteering `protobuf:"bytes,25,opt,name=trafficSteering,proto3" json:"trafficSteering,omitempty"`
+	// dnn, if set, is the Network Instance of the PDRs and FARs of the sessions, overriding the default DNN of the server
+	Dnn string `protobuf:"bytes,26,opt,name=dnn,proto3" json:"dnn,omitempty"`
+	// duplicateUEAddressPolicy defines how sessions whose UE address is used by another session of the batch are handled
+	DuplicateUEAddressPolicy DuplicateUEAddressPolicy `protobuf:"varint,27,opt,name=duplicateUEAddressPolicy,proto3,enum=api.DuplicateUEAddressPolicy" json:"duplicateUEAddressPolicy,omitempty"`
+	// checkActiveUEAddresses extends the duplicate UE address check to the active sessions
+	CheckActiveUEAddresses bool `protobuf:"varint,28,opt,name=checkActiveUEAddresses,proto3" json:"checkActiveUEAddresses,omitempty"`
+	// inactivityTimerMs, if set, is the User Plane Inactivity Timer of the sessions, after which the UPF reports their inactivity.
+	// It is encoded in seconds: it must be a whole number of seconds
+	InactivityTimerMs uint32 `protobuf:"varint,29,opt,name=inactivityTimerMs,proto3" json:"inactivityTimerMs,omitempty"`
+	// ruleIDs, if set, are the explicit IDs of the rules of each application flow, in order, sub-flows included.
+	// They are used as is by all the sessions, instead of being derived from the session IDs and shifted by ruleIDOffset.
+	RuleIDs []*RuleIDs `protobuf:"bytes,30,rep,name=ruleIDs,proto3" json:"ruleIDs,omitempty"`
+	// postCreateDelayMs, if set, is the time in milliseconds to wait once the sessions are established before responding,
+	// for UPFs which finish provisioning sessions asynchronously
+	PostCreateDelayMs uint32 `protobuf:"varint,31,opt,name=postCreateDelayMs,proto3" json:"postCreateDelayMs,omitempty"`
+	// reportRuleStatus makes the response report the provisioning status of the PDRs of each session,
+	// as confirmed by the UPF through the Created PDR IEs
+	ReportRuleStatus bool `protobuf:"varint,32,opt,name=reportRuleStatus,proto3" json:"reportRuleStatus,omitempty"`
+	// reportLatency makes the response report the percentiles of the session establishment latency
+	ReportLatency bool `protobuf:"varint,33,opt,name=reportLatency,proto3" json:"reportLatency,omitempty"`
+	// warmUpCount and warmUpMs, if set, define a warm-up phase whose sessions are excluded from the reported latency:
+	// the first warmUpCount sessions, and the sessions established during the first warmUpMs milliseconds
+	WarmUpCount uint32 `protobuf:"varint,34,opt,name=warmUpCount,proto3" json:"warmUpCount,omitempty"`
+	WarmUpMs    uint32 `protobuf:"varint,35,opt,name=warmUpMs,proto3" json:"warmUpMs,omitempty"`
 }
 
-func (x *EmptyRequest) Reset() {
-	*x = EmptyRequest{}
+func (x *CreateSessionRequest) Reset() {
+	*x = CreateSessionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfcpsim_proto_msgTypes[4]
+		mi := &file_pfcpsim_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EmptyRequest) String() string {
+func (x *CreateSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmptyRequest) ProtoMessage() {}
+func (*CreateSessionRequest) ProtoMessage() {}
 
-func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pfcpsim_proto_msgTypes[4]
+func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,37 +638,288 @@ func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
-func (*EmptyRequest) Descriptor() ([]byte, []int) {
-	return file_pfcpsim_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{3}
 }
 
-type Response struct {
+func (x *CreateSessionRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetBaseID() int32 {
+	if x != nil {
+		return x.BaseID
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetNodeBAddress() string {
+	if x != nil {
+		return x.NodeBAddress
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetUeAddressPool() string {
+	if x != nil {
+		return x.UeAddressPool
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetAppFilters() []string {
+	if x != nil {
+		return x.AppFilters
+	}
+	return nil
+}
+
+func (x *CreateSessionRequest) GetQfi() int32 {
+	if x != nil {
+		return x.Qfi
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetCpFSEIDAddress() string {
+	if x != nil {
+		return x.CpFSEIDAddress
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetUplinkDefaultAction() FARAction {
+	if x != nil {
+		return x.UplinkDefaultAction
+	}
+	return FARAction_ACTION_UNSPECIFIED
+}
+
+func (x *CreateSessionRequest) GetDownlinkDefaultAction() FARAction {
+	if x != nil {
+		return x.DownlinkDefaultAction
+	}
+	return FARAction_ACTION_UNSPECIFIED
+}
+
+func (x *CreateSessionRequest) GetUrr() *URRSpec {
+	if x != nil {
+		return x.Urr
+	}
+	return nil
+}
+
+func (x *CreateSessionRequest) GetFiveQI() int32 {
+	if x != nil {
+		return x.FiveQI
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetUplinkMBR() uint64 {
+	if x != nil {
+		return x.UplinkMBR
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetDownlinkMBR() uint64 {
+	if x != nil {
+		return x.DownlinkMBR
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetUplinkGBR() uint64 {
+	if x != nil {
+		return x.UplinkGBR
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetDownlinkGBR() uint64 {
+	if x != nil {
+		return x.DownlinkGBR
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetUeIPv6PrefixLength() int32 {
+	if x != nil {
+		return x.UeIPv6PrefixLength
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetSessionQerID() uint32 {
+	if x != nil {
+		return x.SessionQerID
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetPredefinedRules() []string {
+	if x != nil {
+		return x.PredefinedRules
+	}
+	return nil
+}
+
+func (x *CreateSessionRequest) GetBitRateUnit() BitRateUnit {
+	if x != nil {
+		return x.BitRateUnit
+	}
+	return BitRateUnit_KBPS
+}
+
+func (x *CreateSessionRequest) GetRuleIDOffset() uint32 {
+	if x != nil {
+		return x.RuleIDOffset
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetHoldTime() *HoldTime {
+	if x != nil {
+		return x.HoldTime
+	}
+	return nil
+}
+
+func (x *CreateSessionRequest) GetInitialDownlinkAction() FARAction {
+	if x != nil {
+		return x.InitialDownlinkAction
+	}
+	return FARAction_ACTION_UNSPECIFIED
+}
+
+func (x *CreateSessionRequest) GetMbs() *MBSSpec {
+	if x != nil {
+		return x.Mbs
+	}
+	return nil
+}
+
+func (x *CreateSessionRequest) GetTrafficEndpoint() bool {
+	if x != nil {
+		return x.TrafficEndpoint
+	}
+	return false
+}
+
+func (x *CreateSessionRequest) GetTrafficSteering() *TrafficSteering {
+	if x != nil {
+		return x.TrafficSteering
+	}
+	return nil
+}
+
+func (x *CreateSessionRequest) GetDnn() string {
+	if x != nil {
+		return x.Dnn
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetDuplicateUEAddressPolicy() DuplicateUEAddressPolicy {
+	if x != nil {
+		return x.DuplicateUEAddressPolicy
+	}
+	return DuplicateUEAddressPolicy_ALLOW_DUPLICATES
+}
+
+func (x *CreateSessionRequest) GetCheckActiveUEAddresses() bool {
+	if x != nil {
+		return x.CheckActiveUEAddresses
+	}
+	return false
+}
+
+func (x *CreateSessionRequest) GetInactivityTimerMs() uint32 {
+	if x != nil {
+		return x.InactivityTimerMs
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetRuleIDs() []*RuleIDs {
+	if x != nil {
+		return x.RuleIDs
+	}
+	return nil
+}
+
+func (x *CreateSessionRequest) GetPostCreateDelayMs() uint32 {
+	if x != nil {
+		return x.PostCreateDelayMs
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetReportRuleStatus() bool {
+	if x != nil {
+		return x.ReportRuleStatus
+	}
+	return false
+}
+
+func (x *CreateSessionRequest) GetReportLatency() bool {
+	if x != nil {
+		return x.ReportLatency
+	}
+	return false
+}
+
+func (x *CreateSessionRequest) GetWarmUpCount() uint32 {
+	if x != nil {
+		return x.WarmUpCount
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest) GetWarmUpMs() uint32 {
+	if x != nil {
+		return x.WarmUpMs
+	}
+	return 0
+}
+
+// RuleIDs are the IDs of the PDRs, FARs and application QERs created for an application flow.
+// Application QERs are only created for the sub-flows of an application filter.
+type RuleIDs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UplinkPDRID   uint32 `protobuf:"varint,1,opt,name=uplinkPDRID,proto3" json:"uplinkPDRID,omitempty"`
+	DownlinkPDRID uint32 `protobuf:"varint,2,opt,name=downlinkPDRID,proto3" json:"downlinkPDRID,omitempty"`
+	UplinkFARID   uint32 `protobuf:"varint,3,opt,name=uplinkFARID,proto3" json:"uplinkFARID,omitempty"`
+	DownlinkFARID uint32 `protobuf:"varint,4,opt,name=downlinkFARID,proto3" json:"downlinkFARID,omitempty"`
+	UplinkQERID   uint32 `protobuf:"varint,5,opt,name=uplinkQERID,proto3" json:"uplinkQERID,omitempty"`
+	DownlinkQERID uint32 `protobuf:"varint,6,opt,name=downlinkQERID,proto3" json:"downlinkQERID,omitempty"`
 }
 
-func (x *Response) Reset() {
-	*x = Response{}
+func (x *RuleIDs) Reset() {
+	*x = RuleIDs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pfcpsim_proto_msgTypes[5]
+		mi := &file_pfcpsim_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Response) String() string {
+func (x *RuleIDs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Response) ProtoMessage() {}
+func (*RuleIDs) ProtoMessage() {}
 
-func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_pfcpsim_proto_msgTypes[5]
+func (x *RuleIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,147 +930,3300 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Response.ProtoReflect.Descriptor instead.
-func (*Response) Descriptor() ([]byte, []int) {
-	return file_pfcpsim_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use RuleIDs.ProtoReflect.Descriptor instead.
+func (*RuleIDs) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Response) GetStatusCode() int32 {
+func (x *RuleIDs) GetUplinkPDRID() uint32 {
 	if x != nil {
-		return x.StatusCode
+		return x.UplinkPDRID
 	}
 	return 0
 }
 
-func (x *Response) GetMessage() string {
+func (x *RuleIDs) GetDownlinkPDRID() uint32 {
 	if x != nil {
-		return x.Message
+		return x.DownlinkPDRID
 	}
-	return ""
+	return 0
 }
 
-var File_pfcpsim_proto protoreflect.FileDescriptor
+func (x *RuleIDs) GetUplinkFARID() uint32 {
+	if x != nil {
+		return x.UplinkFARID
+	}
+	return 0
+}
 
-var file_pfcpsim_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x70, 0x66, 0x63, 0x70, 0x73, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x03, 0x61, 0x70, 0x69, 0x22, 0xc0, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x6e,
-	0x6f, 0x64, 0x65, 0x42, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x42, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x24, 0x0a, 0x0d, 0x75, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x66, 0x69, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x03, 0x71, 0x66, 0x69, 0x22, 0xf2, 0x01, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x69,
-	0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x73, 0x65, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22,
-	0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x42, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x42, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50,
-	0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x75,
-	0x66, 0x66, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x43, 0x50, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x50, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1e, 0x0a, 0x0a,
-	0x61, 0x70, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0a, 0x61, 0x70, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x64, 0x0a, 0x10,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x22, 0x0a, 0x0c, 0x75, 0x70, 0x66, 0x4e, 0x33, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x70, 0x66, 0x4e, 0x33, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x65,
-	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x44, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x49, 0x44, 0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32,
-	0xda, 0x02, 0x0a, 0x07, 0x50, 0x46, 0x43, 0x50, 0x53, 0x69, 0x6d, 0x12, 0x33, 0x0a, 0x09, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x2f, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x32, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x65, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3b, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x07, 0x5a, 0x05,
-	0x2e, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *RuleIDs) GetDownlinkFARID() uint32 {
+	if x != nil {
+		return x.DownlinkFARID
+	}
+	return 0
 }
 
-var (
-	file_pfcpsim_proto_rawDescOnce sync.Once
-	file_pfcpsim_proto_rawDescData = file_pfcpsim_proto_rawDesc
-)
+func (x *RuleIDs) GetUplinkQERID() uint32 {
+	if x != nil {
+		return x.UplinkQERID
+	}
+	return 0
+}
 
-func file_pfcpsim_proto_rawDescGZIP() []byte {
-	file_pfcpsim_proto_rawDescOnce.Do(func() {
-		file_pfcpsim_proto_rawDescData = protoimpl.X.CompressGZIP(file_pfcpsim_proto_rawDescData)
-	})
-	return file_pfcpsim_proto_rawDescData
+func (x *RuleIDs) GetDownlinkQERID() uint32 {
+	if x != nil {
+		return x.DownlinkQERID
+	}
+	return 0
 }
 
-var file_pfcpsim_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_pfcpsim_proto_goTypes = []interface{}{
-	(*CreateSessionRequest)(nil), // 0: api.CreateSessionRequest
-	(*ModifySessionRequest)(nil), // 1: api.ModifySessionRequest
-	(*ConfigureRequest)(nil),     // 2: api.ConfigureRequest
-	(*DeleteSessionRequest)(nil), // 3: api.DeleteSessionRequest
-	(*EmptyRequest)(nil),         // 4: api.EmptyRequest
-	(*Response)(nil),             // 5: api.Response
+// TrafficSteering holds the Traffic Steering Policy Identifiers, carried by the Forwarding Policy IE of the FARs.
+// An empty identifier leaves the traffic of the matching direction unsteered.
+type TrafficSteering struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UplinkPolicyID   string `protobuf:"bytes,1,opt,name=uplinkPolicyID,proto3" json:"uplinkPolicyID,omitempty"`
+	DownlinkPolicyID string `protobuf:"bytes,2,opt,name=downlinkPolicyID,proto3" json:"downlinkPolicyID,omitempty"`
 }
-var file_pfcpsim_proto_depIdxs = []int32{
-	2, // 0: api.PFCPSim.Configure:input_type -> api.ConfigureRequest
-	4, // 1: api.PFCPSim.Associate:input_type -> api.EmptyRequest
-	4, // 2: api.PFCPSim.Disassociate:input_type -> api.EmptyRequest
-	0, // 3: api.PFCPSim.CreateSession:input_type -> api.CreateSessionRequest
-	1, // 4: api.PFCPSim.ModifySession:input_type -> api.ModifySessionRequest
-	3, // 5: api.PFCPSim.DeleteSession:input_type -> api.DeleteSessionRequest
-	5, // 6: api.PFCPSim.Configure:output_type -> api.Response
-	5, // 7: api.PFCPSim.Associate:output_type -> api.Response
-	5, // 8: api.PFCPSim.Disassociate:output_type -> api.Response
-	5, // 9: api.PFCPSim.CreateSession:output_type -> api.Response
-	5, // 10: api.PFCPSim.ModifySession:output_type -> api.Response
-	5, // 11: api.PFCPSim.DeleteSession:output_type -> api.Response
-	6, // [6:12] is the sub-list for method output_type
-	0, // [0:6] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *TrafficSteering) Reset() {
+	*x = TrafficSteering{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func init() { file_pfcpsim_proto_init() }
-func file_pfcpsim_proto_init() {
-	if File_pfcpsim_proto != nil {
-		return
+func (x *TrafficSteering) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficSteering) ProtoMessage() {}
+
+func (x *TrafficSteering) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pfcpsim_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSessionRequest); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficSteering.ProtoReflect.Descriptor instead.
+func (*TrafficSteering) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TrafficSteering) GetUplinkPolicyID() string {
+	if x != nil {
+		return x.UplinkPolicyID
+	}
+	return ""
+}
+
+func (x *TrafficSteering) GetDownlinkPolicyID() string {
+	if x != nil {
+		return x.DownlinkPolicyID
+	}
+	return ""
+}
+
+type ModifySessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// count represents the number of session
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// baseID is used to create incremental IDs for PDRs, FARs, QERs
+	BaseID        int32    `protobuf:"varint,2,opt,name=baseID,proto3" json:"baseID,omitempty"`
+	NodeBAddress  string   `protobuf:"bytes,3,opt,name=nodeBAddress,proto3" json:"nodeBAddress,omitempty"`
+	UeAddressPool string   `protobuf:"bytes,4,opt,name=ueAddressPool,proto3" json:"ueAddressPool,omitempty"`
+	BufferFlag    bool     `protobuf:"varint,5,opt,name=bufferFlag,proto3" json:"bufferFlag,omitempty"`
+	NotifyCPFlag  bool     `protobuf:"varint,6,opt,name=notifyCPFlag,proto3" json:"notifyCPFlag,omitempty"`
+	AppFilters    []string `protobuf:"bytes,7,rep,name=appFilters,proto3" json:"appFilters,omitempty"`
+	// urrVolumeThreshold, if set, updates the URRs of the sessions with this volume threshold, in bytes
+	UrrVolumeThreshold uint64 `protobuf:"varint,8,opt,name=urrVolumeThreshold,proto3" json:"urrVolumeThreshold,omitempty"`
+	// removeURRs removes the URRs of the sessions
+	RemoveURRs bool `protobuf:"varint,9,opt,name=removeURRs,proto3" json:"removeURRs,omitempty"`
+	// ignoreMissing skips the sessions of the batch which are not active, instead of failing before modifying any session
+	IgnoreMissing bool `protobuf:"varint,10,opt,name=ignoreMissing,proto3" json:"ignoreMissing,omitempty"`
+	// sequence, if set, modifies the downlink FARs of the sessions once per step, in order, instead of once according to
+	// bufferFlag and notifyCPFlag (e.g. BUFFER, FORWARD, BUFFER to exercise the downlink data handling of the UPF)
+	Sequence []*ModificationStep `protobuf:"bytes,11,rep,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *ModifySessionRequest) Reset() {
+	*x = ModifySessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifySessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifySessionRequest) ProtoMessage() {}
+
+func (x *ModifySessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifySessionRequest.ProtoReflect.Descriptor instead.
+func (*ModifySessionRequest) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ModifySessionRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ModifySessionRequest) GetBaseID() int32 {
+	if x != nil {
+		return x.BaseID
+	}
+	return 0
+}
+
+func (x *ModifySessionRequest) GetNodeBAddress() string {
+	if x != nil {
+		return x.NodeBAddress
+	}
+	return ""
+}
+
+func (x *ModifySessionRequest) GetUeAddressPool() string {
+	if x != nil {
+		return x.UeAddressPool
+	}
+	return ""
+}
+
+func (x *ModifySessionRequest) GetBufferFlag() bool {
+	if x != nil {
+		return x.BufferFlag
+	}
+	return false
+}
+
+func (x *ModifySessionRequest) GetNotifyCPFlag() bool {
+	if x != nil {
+		return x.NotifyCPFlag
+	}
+	return false
+}
+
+func (x *ModifySessionRequest) GetAppFilters() []string {
+	if x != nil {
+		return x.AppFilters
+	}
+	return nil
+}
+
+func (x *ModifySessionRequest) GetUrrVolumeThreshold() uint64 {
+	if x != nil {
+		return x.UrrVolumeThreshold
+	}
+	return 0
+}
+
+func (x *ModifySessionRequest) GetRemoveURRs() bool {
+	if x != nil {
+		return x.RemoveURRs
+	}
+	return false
+}
+
+func (x *ModifySessionRequest) GetIgnoreMissing() bool {
+	if x != nil {
+		return x.IgnoreMissing
+	}
+	return false
+}
+
+func (x *ModifySessionRequest) GetSequence() []*ModificationStep {
+	if x != nil {
+		return x.Sequence
+	}
+	return nil
+}
+
+// ModificationStep is a modification of the downlink FARs of all the sessions, within a sequence.
+type ModificationStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// action of the downlink FARs: FORWARD to the nodeB address, or BUFFER notifying the CP function
+	Action FARAction `protobuf:"varint,1,opt,name=action,proto3,enum=api.FARAction" json:"action,omitempty"`
+	// delayMs is the time to wait before applying the step, in milliseconds
+	DelayMs uint32 `protobuf:"varint,2,opt,name=delayMs,proto3" json:"delayMs,omitempty"`
+}
+
+func (x *ModificationStep) Reset() {
+	*x = ModificationStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModificationStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModificationStep) ProtoMessage() {}
+
+func (x *ModificationStep) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModificationStep.ProtoReflect.Descriptor instead.
+func (*ModificationStep) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ModificationStep) GetAction() FARAction {
+	if x != nil {
+		return x.Action
+	}
+	return FARAction_ACTION_UNSPECIFIED
+}
+
+func (x *ModificationStep) GetDelayMs() uint32 {
+	if x != nil {
+		return x.DelayMs
+	}
+	return 0
+}
+
+// RuleLimits are the maximum numbers of rules of a session supported by the UPF. Zero means unlimited.
+type RuleLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxPDRs uint32 `protobuf:"varint,1,opt,name=maxPDRs,proto3" json:"maxPDRs,omitempty"`
+	MaxFARs uint32 `protobuf:"varint,2,opt,name=maxFARs,proto3" json:"maxFARs,omitempty"`
+	MaxQERs uint32 `protobuf:"varint,3,opt,name=maxQERs,proto3" json:"maxQERs,omitempty"`
+}
+
+func (x *RuleLimits) Reset() {
+	*x = RuleLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleLimits) ProtoMessage() {}
+
+func (x *RuleLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleLimits.ProtoReflect.Descriptor instead.
+func (*RuleLimits) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RuleLimits) GetMaxPDRs() uint32 {
+	if x != nil {
+		return x.MaxPDRs
+	}
+	return 0
+}
+
+func (x *RuleLimits) GetMaxFARs() uint32 {
+	if x != nil {
+		return x.MaxFARs
+	}
+	return 0
+}
+
+func (x *RuleLimits) GetMaxQERs() uint32 {
+	if x != nil {
+		return x.MaxQERs
+	}
+	return 0
+}
+
+// TEIDRange constrains the uplink TEIDs allocated to the sessions, e.g. to avoid collisions between simulators sharing a UPF.
+type TEIDRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min uint32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max uint32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *TEIDRange) Reset() {
+	*x = TEIDRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TEIDRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TEIDRange) ProtoMessage() {}
+
+func (x *TEIDRange) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TEIDRange.ProtoReflect.Descriptor instead.
+func (*TEIDRange) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TEIDRange) GetMin() uint32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *TEIDRange) GetMax() uint32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type ConfigureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the data-plane interface between UPF and gNodeB
+	UpfN3Address string `protobuf:"bytes,1,opt,name=upfN3Address,proto3" json:"upfN3Address,omitempty"`
+	// the PFCP agent server address
+	RemotePeerAddress string `protobuf:"bytes,3,opt,name=remotePeerAddress,proto3" json:"remotePeerAddress,omitempty"`
+	// server-wide validation strictness
+	Strictness Strictness `protobuf:"varint,4,opt,name=strictness,proto3,enum=api.Strictness" json:"strictness,omitempty"`
+	// associationCooldownMs, if set, is the minimum time in milliseconds between a disassociation and a new association attempt
+	AssociationCooldownMs uint32 `protobuf:"varint,5,opt,name=associationCooldownMs,proto3" json:"associationCooldownMs,omitempty"`
+	// throttleOnOverload makes session creation wait while the UPF signals an overload
+	ThrottleOnOverload bool `protobuf:"varint,6,opt,name=throttleOnOverload,proto3" json:"throttleOnOverload,omitempty"`
+	// includedIETypes, if not empty, are the types of the optional IEs included in sent messages. Other optional IEs are omitted.
+	IncludedIETypes []uint32 `protobuf:"varint,7,rep,packed,name=includedIETypes,proto3" json:"includedIETypes,omitempty"`
+	// excludedIETypes are the types of the IEs omitted from sent messages, even if mandatory. They take precedence over includedIETypes.
+	ExcludedIETypes []uint32 `protobuf:"varint,8,rep,packed,name=excludedIETypes,proto3" json:"excludedIETypes,omitempty"`
+	// nodeType is the CP function advertised in the associations established afterwards
+	NodeType NodeType `protobuf:"varint,9,opt,name=nodeType,proto3,enum=api.NodeType" json:"nodeType,omitempty"`
+	// responseTimeoutMs, if set, is the time in milliseconds to wait for a PFCP response before retransmitting the request,
+	// or failing. If not set, 5 seconds is used
+	ResponseTimeoutMs uint32 `protobuf:"varint,10,opt,name=responseTimeoutMs,proto3" json:"responseTimeoutMs,omitempty"`
+	// retransmissions is the number of times an unanswered PFCP request is retransmitted
+	Retransmissions uint32 `protobuf:"varint,11,opt,name=retransmissions,proto3" json:"retransmissions,omitempty"`
+	// timeoutBackoffMultiplier, if set, multiplies the time to wait for a response after each retransmission.
+	// It must not be lower than 1. If not set, the time to wait is fixed
+	TimeoutBackoffMultiplier float64 `protobuf:"fixed64,12,opt,name=timeoutBackoffMultiplier,proto3" json:"timeoutBackoffMultiplier,omitempty"`
+	// ruleLimits, if set, are validated before establishing sessions, which fail with RESOURCE_EXHAUSTED if they exceed them
+	RuleLimits *RuleLimits `protobuf:"bytes,13,opt,name=ruleLimits,proto3" json:"ruleLimits,omitempty"`
+	// recoveryTimeStamp, if set, is the recovery time stamp advertised by the simulator, in seconds since the Unix epoch.
+	// It defaults to the start time of the simulator
+	RecoveryTimeStamp int64 `protobuf:"varint,14,opt,name=recoveryTimeStamp,proto3" json:"recoveryTimeStamp,omitempty"`
+	// bumpRecoveryTimeStamp advances the recovery time stamp, so that the UPF observes the simulator restarting
+	BumpRecoveryTimeStamp bool `protobuf:"varint,15,opt,name=bumpRecoveryTimeStamp,proto3" json:"bumpRecoveryTimeStamp,omitempty"`
+	// defaultDNN, if set, is the Network Instance of the PDRs and FARs of the sessions created without a DNN.
+	// If not set, "internet" is used by the PDRs, and FARs carry no Network Instance
+	DefaultDNN string `protobuf:"bytes,16,opt,name=defaultDNN,proto3" json:"defaultDNN,omitempty"`
+	// logSequenceWraparound logs whenever the 24-bit PFCP sequence number wraps around, e.g. during soak tests
+	LogSequenceWraparound bool `protobuf:"varint,17,opt,name=logSequenceWraparound,proto3" json:"logSequenceWraparound,omitempty"`
+	// teidRange, if set, constrains the uplink TEIDs of the sessions created afterwards, which fail with RESOURCE_EXHAUSTED
+	// once the range is exhausted. If not set, the uplink TEID of a session is its ID
+	TeidRange *TEIDRange `protobuf:"bytes,18,opt,name=teidRange,proto3" json:"teidRange,omitempty"`
+	// honorOverloadReduction reduces the server maximum session creation rate by the reduction metric advertised by the UPF,
+	// while its overload applies. Otherwise, the reduction metric is ignored. It requires a server maximum session creation rate
+	HonorOverloadReduction bool `protobuf:"varint,19,opt,name=honorOverloadReduction,proto3" json:"honorOverloadReduction,omitempty"`
+}
+
+func (x *ConfigureRequest) Reset() {
+	*x = ConfigureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureRequest) ProtoMessage() {}
+
+func (x *ConfigureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureRequest) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConfigureRequest) GetUpfN3Address() string {
+	if x != nil {
+		return x.UpfN3Address
+	}
+	return ""
+}
+
+func (x *ConfigureRequest) GetRemotePeerAddress() string {
+	if x != nil {
+		return x.RemotePeerAddress
+	}
+	return ""
+}
+
+func (x *ConfigureRequest) GetStrictness() Strictness {
+	if x != nil {
+		return x.Strictness
+	}
+	return Strictness_NORMAL
+}
+
+func (x *ConfigureRequest) GetAssociationCooldownMs() uint32 {
+	if x != nil {
+		return x.AssociationCooldownMs
+	}
+	return 0
+}
+
+func (x *ConfigureRequest) GetThrottleOnOverload() bool {
+	if x != nil {
+		return x.ThrottleOnOverload
+	}
+	return false
+}
+
+func (x *ConfigureRequest) GetIncludedIETypes() []uint32 {
+	if x != nil {
+		return x.IncludedIETypes
+	}
+	return nil
+}
+
+func (x *ConfigureRequest) GetExcludedIETypes() []uint32 {
+	if x != nil {
+		return x.ExcludedIETypes
+	}
+	return nil
+}
+
+func (x *ConfigureRequest) GetNodeType() NodeType {
+	if x != nil {
+		return x.NodeType
+	}
+	return NodeType_NODE_TYPE_UNSPECIFIED
+}
+
+func (x *ConfigureRequest) GetResponseTimeoutMs() uint32 {
+	if x != nil {
+		return x.ResponseTimeoutMs
+	}
+	return 0
+}
+
+func (x *ConfigureRequest) GetRetransmissions() uint32 {
+	if x != nil {
+		return x.Retransmissions
+	}
+	return 0
+}
+
+func (x *ConfigureRequest) GetTimeoutBackoffMultiplier() float64 {
+	if x != nil {
+		return x.TimeoutBackoffMultiplier
+	}
+	return 0
+}
+
+func (x *ConfigureRequest) GetRuleLimits() *RuleLimits {
+	if x != nil {
+		return x.RuleLimits
+	}
+	return nil
+}
+
+func (x *ConfigureRequest) GetRecoveryTimeStamp() int64 {
+	if x != nil {
+		return x.RecoveryTimeStamp
+	}
+	return 0
+}
+
+func (x *ConfigureRequest) GetBumpRecoveryTimeStamp() bool {
+	if x != nil {
+		return x.BumpRecoveryTimeStamp
+	}
+	return false
+}
+
+func (x *ConfigureRequest) GetDefaultDNN() string {
+	if x != nil {
+		return x.DefaultDNN
+	}
+	return ""
+}
+
+func (x *ConfigureRequest) GetLogSequenceWraparound() bool {
+	if x != nil {
+		return x.LogSequenceWraparound
+	}
+	return false
+}
+
+func (x *ConfigureRequest) GetTeidRange() *TEIDRange {
+	if x != nil {
+		return x.TeidRange
+	}
+	return nil
+}
+
+func (x *ConfigureRequest) GetHonorOverloadReduction() bool {
+	if x != nil {
+		return x.HonorOverloadReduction
+	}
+	return false
+}
+
+type DeleteSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// baseID is used to decide where to start deleting sessions
+	BaseID int32 `protobuf:"varint,2,opt,name=baseID,proto3" json:"baseID,omitempty"`
+}
+
+func (x *DeleteSessionRequest) Reset() {
+	*x = DeleteSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionRequest) ProtoMessage() {}
+
+func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteSessionRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DeleteSessionRequest) GetBaseID() int32 {
+	if x != nil {
+		return x.BaseID
+	}
+	return 0
+}
+
+type EmptyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyRequest) Reset() {
+	*x = EmptyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyRequest) ProtoMessage() {}
+
+func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
+func (*EmptyRequest) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{12}
+}
+
+type Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// transactionID, if set, identifies the call in the simulator logs (e.g. the sessions created by a CreateSession call)
+	TransactionID string `protobuf:"bytes,3,opt,name=transactionID,proto3" json:"transactionID,omitempty"`
+	// ruleStatus is the provisioning status of the PDRs of each created session, if requested
+	RuleStatus []*SessionRuleStatus `protobuf:"bytes,4,rep,name=ruleStatus,proto3" json:"ruleStatus,omitempty"`
+	// latency is the session establishment latency of the created sessions, if requested
+	Latency *LatencyReport `protobuf:"bytes,5,opt,name=latency,proto3" json:"latency,omitempty"`
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Response) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *Response) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Response) GetTransactionID() string {
+	if x != nil {
+		return x.TransactionID
+	}
+	return ""
+}
+
+func (x *Response) GetRuleStatus() []*SessionRuleStatus {
+	if x != nil {
+		return x.RuleStatus
+	}
+	return nil
+}
+
+func (x *Response) GetLatency() *LatencyReport {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+// LatencyReport is the distribution of the session establishment latency, warm-up phase excluded.
+type LatencyReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// samples is the number of sessions the percentiles are computed from
+	Samples int32 `protobuf:"varint,1,opt,name=samples,proto3" json:"samples,omitempty"`
+	// warmUpSessions is the number of sessions excluded as part of the warm-up phase
+	WarmUpSessions int32 `protobuf:"varint,2,opt,name=warmUpSessions,proto3" json:"warmUpSessions,omitempty"`
+	// p50Us, p90Us, p99Us and maxUs are latency percentiles, in microseconds
+	P50Us uint64 `protobuf:"varint,3,opt,name=p50Us,proto3" json:"p50Us,omitempty"`
+	P90Us uint64 `protobuf:"varint,4,opt,name=p90Us,proto3" json:"p90Us,omitempty"`
+	P99Us uint64 `protobuf:"varint,5,opt,name=p99Us,proto3" json:"p99Us,omitempty"`
+	MaxUs uint64 `protobuf:"varint,6,opt,name=maxUs,proto3" json:"maxUs,omitempty"`
+}
+
+func (x *LatencyReport) Reset() {
+	*x = LatencyReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatencyReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyReport) ProtoMessage() {}
+
+func (x *LatencyReport) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyReport.ProtoReflect.Descriptor instead.
+func (*LatencyReport) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LatencyReport) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *LatencyReport) GetWarmUpSessions() int32 {
+	if x != nil {
+		return x.WarmUpSessions
+	}
+	return 0
+}
+
+func (x *LatencyReport) GetP50Us() uint64 {
+	if x != nil {
+		return x.P50Us
+	}
+	return 0
+}
+
+func (x *LatencyReport) GetP90Us() uint64 {
+	if x != nil {
+		return x.P90Us
+	}
+	return 0
+}
+
+func (x *LatencyReport) GetP99Us() uint64 {
+	if x != nil {
+		return x.P99Us
+	}
+	return 0
+}
+
+func (x *LatencyReport) GetMaxUs() uint64 {
+	if x != nil {
+		return x.MaxUs
+	}
+	return 0
+}
+
+// RuleStatus is the provisioning status of a PDR.
+type RuleStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PdrID uint32 `protobuf:"varint,1,opt,name=pdrID,proto3" json:"pdrID,omitempty"`
+	// confirmed is true if the UPF returned a Created PDR IE for the PDR
+	Confirmed bool `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// teid and n3Address are the F-TEID allocated by the UPF, if any
+	Teid      uint32 `protobuf:"varint,3,opt,name=teid,proto3" json:"teid,omitempty"`
+	N3Address string `protobuf:"bytes,4,opt,name=n3Address,proto3" json:"n3Address,omitempty"`
+	// ueAddress is the UE IP address allocated by the UPF, if any
+	UeAddress string `protobuf:"bytes,5,opt,name=ueAddress,proto3" json:"ueAddress,omitempty"`
+}
+
+func (x *RuleStatus) Reset() {
+	*x = RuleStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleStatus) ProtoMessage() {}
+
+func (x *RuleStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleStatus.ProtoReflect.Descriptor instead.
+func (*RuleStatus) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RuleStatus) GetPdrID() uint32 {
+	if x != nil {
+		return x.PdrID
+	}
+	return 0
+}
+
+func (x *RuleStatus) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *RuleStatus) GetTeid() uint32 {
+	if x != nil {
+		return x.Teid
+	}
+	return 0
+}
+
+func (x *RuleStatus) GetN3Address() string {
+	if x != nil {
+		return x.N3Address
+	}
+	return ""
+}
+
+func (x *RuleStatus) GetUeAddress() string {
+	if x != nil {
+		return x.UeAddress
+	}
+	return ""
+}
+
+type SessionRuleStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID int32 `protobuf:"varint,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	// rules are in the order of the PDRs of the session
+	Rules []*RuleStatus `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *SessionRuleStatus) Reset() {
+	*x = SessionRuleStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionRuleStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRuleStatus) ProtoMessage() {}
+
+func (x *SessionRuleStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRuleStatus.ProtoReflect.Descriptor instead.
+func (*SessionRuleStatus) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SessionRuleStatus) GetSessionID() int32 {
+	if x != nil {
+		return x.SessionID
+	}
+	return 0
+}
+
+func (x *SessionRuleStatus) GetRules() []*RuleStatus {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SessionCountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// count is the number of active sessions
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// associated is true if an association with the remote peer is established
+	Associated bool `protobuf:"varint,2,opt,name=associated,proto3" json:"associated,omitempty"`
+}
+
+func (x *SessionCountResponse) Reset() {
+	*x = SessionCountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionCountResponse) ProtoMessage() {}
+
+func (x *SessionCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionCountResponse.ProtoReflect.Descriptor instead.
+func (*SessionCountResponse) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SessionCountResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SessionCountResponse) GetAssociated() bool {
+	if x != nil {
+		return x.Associated
+	}
+	return false
+}
+
+// BatchFailure is attached to the status of a session RPC failing partway through its sessions.
+type BatchFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// succeeded is the number of sessions processed before the failure, skipped sessions excluded
+	Succeeded int32 `protobuf:"varint,1,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	// failedIndex is the position (starting from 1) of the failed session among the processed ones
+	FailedIndex int32 `protobuf:"varint,2,opt,name=failedIndex,proto3" json:"failedIndex,omitempty"`
+	// failedSessionID is the ID of the failed session, i.e. the baseID to resume from
+	FailedSessionID int32 `protobuf:"varint,3,opt,name=failedSessionID,proto3" json:"failedSessionID,omitempty"`
+	// cause is the error which made the session fail
+	Cause string `protobuf:"bytes,4,opt,name=cause,proto3" json:"cause,omitempty"`
+}
+
+func (x *BatchFailure) Reset() {
+	*x = BatchFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchFailure) ProtoMessage() {}
+
+func (x *BatchFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchFailure.ProtoReflect.Descriptor instead.
+func (*BatchFailure) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchFailure) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchFailure) GetFailedIndex() int32 {
+	if x != nil {
+		return x.FailedIndex
+	}
+	return 0
+}
+
+func (x *BatchFailure) GetFailedSessionID() int32 {
+	if x != nil {
+		return x.FailedSessionID
+	}
+	return 0
+}
+
+func (x *BatchFailure) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+type GetSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sessionID is the ID of the session, derived from the baseID it was created with
+	SessionID int32 `protobuf:"varint,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetSessionRequest) GetSessionID() int32 {
+	if x != nil {
+		return x.SessionID
+	}
+	return 0
+}
+
+// PDRInfo describes a PDR of a session.
+type PDRInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Precedence uint32 `protobuf:"varint,2,opt,name=precedence,proto3" json:"precedence,omitempty"`
+	// sourceInterface is the interface value of the PDI (e.g. 0 for Access, 1 for Core)
+	SourceInterface uint32 `protobuf:"varint,3,opt,name=sourceInterface,proto3" json:"sourceInterface,omitempty"`
+	// sdfFilter is the flow description of the PDI, if any
+	SdfFilter string   `protobuf:"bytes,4,opt,name=sdfFilter,proto3" json:"sdfFilter,omitempty"`
+	FarID     uint32   `protobuf:"varint,5,opt,name=farID,proto3" json:"farID,omitempty"`
+	QerIDs    []uint32 `protobuf:"varint,6,rep,packed,name=qerIDs,proto3" json:"qerIDs,omitempty"`
+	UrrIDs    []uint32 `protobuf:"varint,7,rep,packed,name=urrIDs,proto3" json:"urrIDs,omitempty"`
+}
+
+func (x *PDRInfo) Reset() {
+	*x = PDRInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PDRInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PDRInfo) ProtoMessage() {}
+
+func (x *PDRInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PDRInfo.ProtoReflect.Descriptor instead.
+func (*PDRInfo) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PDRInfo) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PDRInfo) GetPrecedence() uint32 {
+	if x != nil {
+		return x.Precedence
+	}
+	return 0
+}
+
+func (x *PDRInfo) GetSourceInterface() uint32 {
+	if x != nil {
+		return x.SourceInterface
+	}
+	return 0
+}
+
+func (x *PDRInfo) GetSdfFilter() string {
+	if x != nil {
+		return x.SdfFilter
+	}
+	return ""
+}
+
+func (x *PDRInfo) GetFarID() uint32 {
+	if x != nil {
+		return x.FarID
+	}
+	return 0
+}
+
+func (x *PDRInfo) GetQerIDs() []uint32 {
+	if x != nil {
+		return x.QerIDs
+	}
+	return nil
+}
+
+func (x *PDRInfo) GetUrrIDs() []uint32 {
+	if x != nil {
+		return x.UrrIDs
+	}
+	return nil
+}
+
+// FARInfo describes a FAR of a session.
+type FARInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// applyAction are the Apply Action flags (refer to 3GPP TS 29.244, section 8.2.26)
+	ApplyAction uint32 `protobuf:"varint,2,opt,name=applyAction,proto3" json:"applyAction,omitempty"`
+	// outerHeaderAddress and outerHeaderTEID are the GTP-U tunnel downlink traffic is forwarded to, if any
+	OuterHeaderAddress string `protobuf:"bytes,3,opt,name=outerHeaderAddress,proto3" json:"outerHeaderAddress,omitempty"`
+	OuterHeaderTEID    uint32 `protobuf:"varint,4,opt,name=outerHeaderTEID,proto3" json:"outerHeaderTEID,omitempty"`
+}
+
+func (x *FARInfo) Reset() {
+	*x = FARInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FARInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FARInfo) ProtoMessage() {}
+
+func (x *FARInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FARInfo.ProtoReflect.Descriptor instead.
+func (*FARInfo) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FARInfo) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FARInfo) GetApplyAction() uint32 {
+	if x != nil {
+		return x.ApplyAction
+	}
+	return 0
+}
+
+func (x *FARInfo) GetOuterHeaderAddress() string {
+	if x != nil {
+		return x.OuterHeaderAddress
+	}
+	return ""
+}
+
+func (x *FARInfo) GetOuterHeaderTEID() uint32 {
+	if x != nil {
+		return x.OuterHeaderTEID
+	}
+	return 0
+}
+
+// QERInfo describes a QER of a session.
+type QERInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Qfi uint32 `protobuf:"varint,2,opt,name=qfi,proto3" json:"qfi,omitempty"`
+	// uplinkMBR and downlinkMBR are expressed in kbps. Zero means not set
+	UplinkMBR   uint64 `protobuf:"varint,3,opt,name=uplinkMBR,proto3" json:"uplinkMBR,omitempty"`
+	DownlinkMBR uint64 `protobuf:"varint,4,opt,name=downlinkMBR,proto3" json:"downlinkMBR,omitempty"`
+}
+
+func (x *QERInfo) Reset() {
+	*x = QERInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QERInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QERInfo) ProtoMessage() {}
+
+func (x *QERInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QERInfo.ProtoReflect.Descriptor instead.
+func (*QERInfo) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QERInfo) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QERInfo) GetQfi() uint32 {
+	if x != nil {
+		return x.Qfi
+	}
+	return 0
+}
+
+func (x *QERInfo) GetUplinkMBR() uint64 {
+	if x != nil {
+		return x.UplinkMBR
+	}
+	return 0
+}
+
+func (x *QERInfo) GetDownlinkMBR() uint64 {
+	if x != nil {
+		return x.DownlinkMBR
+	}
+	return 0
+}
+
+type SessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID int32  `protobuf:"varint,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	LocalSEID uint64 `protobuf:"varint,2,opt,name=localSEID,proto3" json:"localSEID,omitempty"`
+	PeerSEID  uint64 `protobuf:"varint,3,opt,name=peerSEID,proto3" json:"peerSEID,omitempty"`
+	// ueAddress is the UE address, or the delegated IPv6 prefix
+	UeAddress  string `protobuf:"bytes,4,opt,name=ueAddress,proto3" json:"ueAddress,omitempty"`
+	UplinkTEID uint32 `protobuf:"varint,5,opt,name=uplinkTEID,proto3" json:"uplinkTEID,omitempty"`
+	// pdrs, fars and qers are the rules the session was established with
+	Pdrs []*PDRInfo `protobuf:"bytes,6,rep,name=pdrs,proto3" json:"pdrs,omitempty"`
+	Fars []*FARInfo `protobuf:"bytes,7,rep,name=fars,proto3" json:"fars,omitempty"`
+	Qers []*QERInfo `protobuf:"bytes,8,rep,name=qers,proto3" json:"qers,omitempty"`
+	// urrIDs are the IDs of the current URRs of the session
+	UrrIDs []uint32 `protobuf:"varint,9,rep,packed,name=urrIDs,proto3" json:"urrIDs,omitempty"`
+}
+
+func (x *SessionResponse) Reset() {
+	*x = SessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionResponse) ProtoMessage() {}
+
+func (x *SessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionResponse.ProtoReflect.Descriptor instead.
+func (*SessionResponse) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SessionResponse) GetSessionID() int32 {
+	if x != nil {
+		return x.SessionID
+	}
+	return 0
+}
+
+func (x *SessionResponse) GetLocalSEID() uint64 {
+	if x != nil {
+		return x.LocalSEID
+	}
+	return 0
+}
+
+func (x *SessionResponse) GetPeerSEID() uint64 {
+	if x != nil {
+		return x.PeerSEID
+	}
+	return 0
+}
+
+func (x *SessionResponse) GetUeAddress() string {
+	if x != nil {
+		return x.UeAddress
+	}
+	return ""
+}
+
+func (x *SessionResponse) GetUplinkTEID() uint32 {
+	if x != nil {
+		return x.UplinkTEID
+	}
+	return 0
+}
+
+func (x *SessionResponse) GetPdrs() []*PDRInfo {
+	if x != nil {
+		return x.Pdrs
+	}
+	return nil
+}
+
+func (x *SessionResponse) GetFars() []*FARInfo {
+	if x != nil {
+		return x.Fars
+	}
+	return nil
+}
+
+func (x *SessionResponse) GetQers() []*QERInfo {
+	if x != nil {
+		return x.Qers
+	}
+	return nil
+}
+
+func (x *SessionResponse) GetUrrIDs() []uint32 {
+	if x != nil {
+		return x.UrrIDs
+	}
+	return nil
+}
+
+// SessionMapping relates an active session to the identifiers external tools (e.g. packet captures, UPF dumps) reference it by.
+type SessionMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID int32  `protobuf:"varint,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	LocalSEID uint64 `protobuf:"varint,2,opt,name=localSEID,proto3" json:"localSEID,omitempty"`
+	PeerSEID  uint64 `protobuf:"varint,3,opt,name=peerSEID,proto3" json:"peerSEID,omitempty"`
+	// ueAddress is the UE address, or the delegated IPv6 prefix
+	UeAddress string `protobuf:"bytes,4,opt,name=ueAddress,proto3" json:"ueAddress,omitempty"`
+}
+
+func (x *SessionMapping) Reset() {
+	*x = SessionMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionMapping) ProtoMessage() {}
+
+func (x *SessionMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionMapping.ProtoReflect.Descriptor instead.
+func (*SessionMapping) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SessionMapping) GetSessionID() int32 {
+	if x != nil {
+		return x.SessionID
+	}
+	return 0
+}
+
+func (x *SessionMapping) GetLocalSEID() uint64 {
+	if x != nil {
+		return x.LocalSEID
+	}
+	return 0
+}
+
+func (x *SessionMapping) GetPeerSEID() uint64 {
+	if x != nil {
+		return x.PeerSEID
+	}
+	return 0
+}
+
+func (x *SessionMapping) GetUeAddress() string {
+	if x != nil {
+		return x.UeAddress
+	}
+	return ""
+}
+
+type SessionMappingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sessions are the mappings of all the active sessions, sorted by session ID
+	Sessions []*SessionMapping `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *SessionMappingResponse) Reset() {
+	*x = SessionMappingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionMappingResponse) ProtoMessage() {}
+
+func (x *SessionMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionMappingResponse.ProtoReflect.Descriptor instead.
+func (*SessionMappingResponse) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SessionMappingResponse) GetSessions() []*SessionMapping {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type SetDrainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// drain, if true, rejects the creation of new sessions. If false, stops draining
+	Drain bool `protobuf:"varint,1,opt,name=drain,proto3" json:"drain,omitempty"`
+}
+
+func (x *SetDrainRequest) Reset() {
+	*x = SetDrainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDrainRequest) ProtoMessage() {}
+
+func (x *SetDrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDrainRequest.ProtoReflect.Descriptor instead.
+func (*SetDrainRequest) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetDrainRequest) GetDrain() bool {
+	if x != nil {
+		return x.Drain
+	}
+	return false
+}
+
+type SetupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// configure, if set, configures the server before associating. The configuration is kept even if the setup fails
+	Configure *ConfigureRequest `protobuf:"bytes,1,opt,name=configure,proto3" json:"configure,omitempty"`
+	// sessions are the sessions created once associated
+	Sessions *CreateSessionRequest `protobuf:"bytes,2,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	// retries is the number of times the setup is attempted again, once a failed attempt is rolled back
+	Retries uint32 `protobuf:"varint,3,opt,name=retries,proto3" json:"retries,omitempty"`
+	// retryDelayMs is the time in milliseconds to wait before attempting the setup again
+	RetryDelayMs uint32 `protobuf:"varint,4,opt,name=retryDelayMs,proto3" json:"retryDelayMs,omitempty"`
+}
+
+func (x *SetupRequest) Reset() {
+	*x = SetupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupRequest) ProtoMessage() {}
+
+func (x *SetupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupRequest.ProtoReflect.Descriptor instead.
+func (*SetupRequest) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetupRequest) GetConfigure() *ConfigureRequest {
+	if x != nil {
+		return x.Configure
+	}
+	return nil
+}
+
+func (x *SetupRequest) GetSessions() *CreateSessionRequest {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *SetupRequest) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *SetupRequest) GetRetryDelayMs() uint32 {
+	if x != nil {
+		return x.RetryDelayMs
+	}
+	return 0
+}
+
+type SelfTestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// baseID of the test session. If not set, the first ID not used by any active session
+	BaseID int32 `protobuf:"varint,1,opt,name=baseID,proto3" json:"baseID,omitempty"`
+	// nodeBAddress of the test session. If not set, 10.0.0.1 is used
+	NodeBAddress string `protobuf:"bytes,2,opt,name=nodeBAddress,proto3" json:"nodeBAddress,omitempty"`
+	// ueAddressPool the address of the test UE is taken from. If not set, 17.0.0.0/24 is used
+	UeAddressPool string `protobuf:"bytes,3,opt,name=ueAddressPool,proto3" json:"ueAddressPool,omitempty"`
+}
+
+func (x *SelfTestRequest) Reset() {
+	*x = SelfTestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfTestRequest) ProtoMessage() {}
+
+func (x *SelfTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfTestRequest.ProtoReflect.Descriptor instead.
+func (*SelfTestRequest) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SelfTestRequest) GetBaseID() int32 {
+	if x != nil {
+		return x.BaseID
+	}
+	return 0
+}
+
+func (x *SelfTestRequest) GetNodeBAddress() string {
+	if x != nil {
+		return x.NodeBAddress
+	}
+	return ""
+}
+
+func (x *SelfTestRequest) GetUeAddressPool() string {
+	if x != nil {
+		return x.UeAddressPool
+	}
+	return ""
+}
+
+// SelfTestStep is the outcome of a step of the self-test.
+type SelfTestStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed     bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	DurationMs uint64 `protobuf:"varint,3,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
+	// error is the reason why the step failed, if it did
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SelfTestStep) Reset() {
+	*x = SelfTestStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfTestStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfTestStep) ProtoMessage() {}
+
+func (x *SelfTestStep) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfTestStep.ProtoReflect.Descriptor instead.
+func (*SelfTestStep) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SelfTestStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SelfTestStep) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *SelfTestStep) GetDurationMs() uint64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *SelfTestStep) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SelfTestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// passed is true if all the steps passed
+	Passed bool            `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	Steps  []*SelfTestStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *SelfTestResponse) Reset() {
+	*x = SelfTestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfTestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfTestResponse) ProtoMessage() {}
+
+func (x *SelfTestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfTestResponse.ProtoReflect.Descriptor instead.
+func (*SelfTestResponse) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SelfTestResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *SelfTestResponse) GetSteps() []*SelfTestStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type CheckDataPathRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// n3Address is the GTP-U address to echo. It can include a port (e.g. 10.0.0.1:2152).
+	// If not set, the configured UPF N3 address is used.
+	N3Address string `protobuf:"bytes,1,opt,name=n3Address,proto3" json:"n3Address,omitempty"`
+	// timeoutMs is the time in milliseconds to wait for the echo response. If not set, 1 second is used
+	TimeoutMs uint32 `protobuf:"varint,2,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`
+}
+
+func (x *CheckDataPathRequest) Reset() {
+	*x = CheckDataPathRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckDataPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDataPathRequest) ProtoMessage() {}
+
+func (x *CheckDataPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDataPathRequest.ProtoReflect.Descriptor instead.
+func (*CheckDataPathRequest) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CheckDataPathRequest) GetN3Address() string {
+	if x != nil {
+		return x.N3Address
+	}
+	return ""
+}
+
+func (x *CheckDataPathRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type CheckDataPathResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reachable is true if the GTP-U peer answered the echo request
+	Reachable bool `protobuf:"varint,1,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	// roundTripTimeUs is the round-trip time of the echo, in microseconds
+	RoundTripTimeUs uint64 `protobuf:"varint,2,opt,name=roundTripTimeUs,proto3" json:"roundTripTimeUs,omitempty"`
+	// error is the reason why the GTP-U peer is not reachable, if it is not
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CheckDataPathResponse) Reset() {
+	*x = CheckDataPathResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckDataPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDataPathResponse) ProtoMessage() {}
+
+func (x *CheckDataPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDataPathResponse.ProtoReflect.Descriptor instead.
+func (*CheckDataPathResponse) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CheckDataPathResponse) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *CheckDataPathResponse) GetRoundTripTimeUs() uint64 {
+	if x != nil {
+		return x.RoundTripTimeUs
+	}
+	return 0
+}
+
+func (x *CheckDataPathResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// LoadControlInfo is the load advertised by the UPF through the Load Control Information IE.
+type LoadControlInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SequenceNumber uint32 `protobuf:"varint,1,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
+	// metric is the load of the UPF, in percentage
+	Metric uint32 `protobuf:"varint,2,opt,name=metric,proto3" json:"metric,omitempty"`
+}
+
+func (x *LoadControlInfo) Reset() {
+	*x = LoadControlInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadControlInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadControlInfo) ProtoMessage() {}
+
+func (x *LoadControlInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadControlInfo.ProtoReflect.Descriptor instead.
+func (*LoadControlInfo) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LoadControlInfo) GetSequenceNumber() uint32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *LoadControlInfo) GetMetric() uint32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+// OverloadControlInfo is the overload advertised by the UPF through the Overload Control Information IE.
+type OverloadControlInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SequenceNumber uint32 `protobuf:"varint,1,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
+	// reductionMetric is the percentage of traffic the UPF requests to reduce
+	ReductionMetric uint32 `protobuf:"varint,2,opt,name=reductionMetric,proto3" json:"reductionMetric,omitempty"`
+	// remainingValidityMs is the time in milliseconds before the overload stops applying
+	RemainingValidityMs uint64 `protobuf:"varint,3,opt,name=remainingValidityMs,proto3" json:"remainingValidityMs,omitempty"`
+	// active is true until the overload validity period expires, unless no reduction is requested
+	Active bool `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *OverloadControlInfo) Reset() {
+	*x = OverloadControlInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OverloadControlInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverloadControlInfo) ProtoMessage() {}
+
+func (x *OverloadControlInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverloadControlInfo.ProtoReflect.Descriptor instead.
+func (*OverloadControlInfo) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *OverloadControlInfo) GetSequenceNumber() uint32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *OverloadControlInfo) GetReductionMetric() uint32 {
+	if x != nil {
+		return x.ReductionMetric
+	}
+	return 0
+}
+
+func (x *OverloadControlInfo) GetRemainingValidityMs() uint64 {
+	if x != nil {
+		return x.RemainingValidityMs
+	}
+	return 0
+}
+
+func (x *OverloadControlInfo) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type UPFLoadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// load and overload are the latest ones advertised by the UPF in session responses, if any
+	Load     *LoadControlInfo     `protobuf:"bytes,1,opt,name=load,proto3" json:"load,omitempty"`
+	Overload *OverloadControlInfo `protobuf:"bytes,2,opt,name=overload,proto3" json:"overload,omitempty"`
+}
+
+func (x *UPFLoadResponse) Reset() {
+	*x = UPFLoadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pfcpsim_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UPFLoadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UPFLoadResponse) ProtoMessage() {}
+
+func (x *UPFLoadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pfcpsim_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UPFLoadResponse.ProtoReflect.Descriptor instead.
+func (*UPFLoadResponse) Descriptor() ([]byte, []int) {
+	return file_pfcpsim_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UPFLoadResponse) GetLoad() *LoadControlInfo {
+	if x != nil {
+		return x.Load
+	}
+	return nil
+}
+
+func (x *UPFLoadResponse) GetOverload() *OverloadControlInfo {
+	if x != nil {
+		return x.Overload
+	}
+	return nil
+}
+
+var File_pfcpsim_proto protoreflect.FileDescriptor
+
+var file_pfcpsim_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x70, 0x66, 0x63, 0x70, 0x73, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x53, 0x0a, 0x07, 0x55, 0x52, 0x52, 0x53, 0x70, 0x65, 0x63, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x07, 0x4d, 0x42, 0x53, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x69, 0x0a, 0x08, 0x48, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x6f, 0x6c,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xb2, 0x0b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x62, 0x61, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x42, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f,
+	0x64, 0x65, 0x42, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x75, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x71, 0x66, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x71,
+	0x66, 0x69, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x70, 0x46, 0x53, 0x45, 0x49, 0x44, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x70, 0x46, 0x53,
+	0x45, 0x49, 0x44, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x13, 0x75, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x41,
+	0x52, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x15,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x46, 0x41, 0x52, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x03, 0x75, 0x72, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x52, 0x52, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x75,
+	0x72, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x76, 0x65, 0x51, 0x49, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x66, 0x69, 0x76, 0x65, 0x51, 0x49, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x42, 0x52, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75,
+	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x42, 0x52, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x42, 0x52, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x42, 0x52, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x47, 0x42, 0x52, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75,
+	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x47, 0x42, 0x52, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x47, 0x42, 0x52, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x47, 0x42, 0x52, 0x12, 0x2e, 0x0a, 0x12, 0x75, 0x65,
+	0x49, 0x50, 0x76, 0x36, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x75, 0x65, 0x49, 0x50, 0x76, 0x36, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x51, 0x65, 0x72, 0x49, 0x44, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x51, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28,
+	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x62, 0x69, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x0b, 0x62, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x29, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x15, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x46, 0x41, 0x52, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x03, 0x6d, 0x62, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x42, 0x53, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x6d, 0x62,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0f, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x6e, 0x6e, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x6e, 0x6e, 0x12, 0x59, 0x0a,
+	0x18, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x45, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55,
+	0x45, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x18,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x45, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x16, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x45, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x45, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69,
+	0x6d, 0x65, 0x72, 0x4d, 0x73, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69, 0x6e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x4d, 0x73, 0x12, 0x26,
+	0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x52, 0x07, 0x72,
+	0x75, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x4d, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x61, 0x72, 0x6d, 0x55, 0x70,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x77, 0x61, 0x72,
+	0x6d, 0x55, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6d,
+	0x55, 0x70, 0x4d, 0x73, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6d,
+	0x55, 0x70, 0x4d, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x07, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x44, 0x52, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x44, 0x52,
+	0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x44,
+	0x52, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x50, 0x44, 0x52, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x69,
+	0x6e, 0x6b, 0x46, 0x41, 0x52, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75,
+	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x46, 0x41, 0x52, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x46, 0x41, 0x52, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x46, 0x41, 0x52, 0x49, 0x44,
+	0x12, 0x20, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x45, 0x52, 0x49, 0x44, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x45, 0x52,
+	0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x45,
+	0x52, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x51, 0x45, 0x52, 0x49, 0x44, 0x22, 0x65, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x75,
+	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x44, 0x22,
+	0x9b, 0x03, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x62, 0x61, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x42, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f,
+	0x64, 0x65, 0x42, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x75, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67,
+	0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x50, 0x46, 0x6c, 0x61, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x50,
+	0x46, 0x6c, 0x61, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x75, 0x72, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x12, 0x75, 0x72, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x52,
+	0x52, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x55, 0x52, 0x52, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x65, 0x70, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x54, 0x0a,
+	0x10, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65,
+	0x70, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x41, 0x52, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x4d, 0x73, 0x22, 0x5a, 0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x50, 0x44, 0x52, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x50, 0x44, 0x52, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x61, 0x78, 0x46, 0x41, 0x52, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61,
+	0x78, 0x46, 0x41, 0x52, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x51, 0x45, 0x52, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x51, 0x45, 0x52, 0x73, 0x22,
+	0x2f, 0x0a, 0x09, 0x54, 0x45, 0x49, 0x44, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61, 0x78,
+	0x22, 0xdf, 0x06, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x70, 0x66, 0x4e, 0x33, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x70, 0x66,
+	0x4e, 0x33, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x0a, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x73, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x4d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x4d, 0x73, 0x12, 0x2e,
+	0x0a, 0x12, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x6e, 0x4f, 0x76, 0x65, 0x72,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x74, 0x68, 0x72, 0x6f,
+	0x74, 0x74, 0x6c, 0x65, 0x4f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x28,
+	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x49, 0x45, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x49, 0x45, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x49, 0x45, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x49, 0x45, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a,
+	0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x4d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x72,
+	0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x18, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x34, 0x0a, 0x15, 0x62, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x15, 0x62, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x44, 0x4e, 0x4e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x44, 0x4e, 0x4e, 0x12, 0x34, 0x0a, 0x15, 0x6c, 0x6f, 0x67, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x57, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x6c, 0x6f, 0x67, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x57, 0x72, 0x61, 0x70, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x09,
+	0x74, 0x65, 0x69, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x45, 0x49, 0x44, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x09, 0x74, 0x65, 0x69, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x68, 0x6f,
+	0x6e, 0x6f, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x68, 0x6f, 0x6e, 0x6f,
+	0x72, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x49, 0x44, 0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c,
+	0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xa9, 0x01, 0x0a,
+	0x0d, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x61, 0x72, 0x6d,
+	0x55, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x77, 0x61, 0x72, 0x6d, 0x55, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x35, 0x30, 0x55, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x70, 0x35, 0x30, 0x55, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x39, 0x30, 0x55, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x39, 0x30, 0x55, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x39, 0x39, 0x55, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x39, 0x39,
+	0x55, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x0a, 0x52, 0x75, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x64, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x64, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x33, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x33, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x75, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x58, 0x0a, 0x11, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x25,
+	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x14, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x65, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64,
+	0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x61, 0x75, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xc7, 0x01, 0x0a, 0x07, 0x50, 0x44, 0x52, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x63, 0x65, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x64, 0x66, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x64, 0x66, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x61, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x61, 0x72, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x06, 0x71, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x72, 0x72,
+	0x49, 0x44, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x72, 0x72, 0x49, 0x44,
+	0x73, 0x22, 0x95, 0x01, 0x0a, 0x07, 0x46, 0x41, 0x52, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2e, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x45,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x45, 0x49, 0x44, 0x22, 0x6b, 0x0a, 0x07, 0x51, 0x45, 0x52,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x66, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x71, 0x66, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x4d, 0x42, 0x52, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x70, 0x6c, 0x69, 0x6e,
+	0x6b, 0x4d, 0x42, 0x52, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x4d, 0x42, 0x52, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x4d, 0x42, 0x52, 0x22, 0xa5, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x53, 0x45, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x53, 0x45, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x53, 0x45,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x53, 0x45,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x45, 0x49, 0x44, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x45, 0x49, 0x44,
+	0x12, 0x20, 0x0a, 0x04, 0x70, 0x64, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x44, 0x52, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x70, 0x64,
+	0x72, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x61, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x41, 0x52, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x66, 0x61, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x71, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x51, 0x45, 0x52, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x71, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x72, 0x72, 0x49, 0x44, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x72, 0x72, 0x49, 0x44, 0x73, 0x22, 0x86,
+	0x01, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x45, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x45, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x65, 0x65, 0x72, 0x53, 0x45, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x70, 0x65, 0x65, 0x72, 0x53, 0x45, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x49, 0x0a, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x27, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x22, 0xb8, 0x01, 0x0a, 0x0c,
+	0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x4d, 0x73, 0x22, 0x73, 0x0a, 0x0f, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x42, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x42, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x22, 0x70, 0x0a, 0x0c, 0x53,
+	0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x53, 0x0a,
+	0x10, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x22, 0x52, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x33,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x33, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x75, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44,
+	0x61, 0x74, 0x61, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69,
+	0x70, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x51, 0x0a,
+	0x0f, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x22, 0xb1, 0x01, 0x0a, 0x13, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x4d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x22, 0x71, 0x0a, 0x0f, 0x55, 0x50, 0x46, 0x4c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x61, 0x64,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x6f,
+	0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6f,
+	0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x46, 0x0a, 0x09, 0x46, 0x41, 0x52, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f,
+	0x50, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x03, 0x2a,
+	0x32, 0x0a, 0x14, 0x48, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x49, 0x58, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x58, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x49, 0x41,
+	0x4c, 0x10, 0x01, 0x2a, 0x2a, 0x0a, 0x0b, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x42, 0x50, 0x53, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x42, 0x50, 0x53, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x42, 0x50, 0x53, 0x10, 0x02, 0x2a,
+	0x5c, 0x0a, 0x18, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x45, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x53, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x55, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x53, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x4b, 0x49, 0x50,
+	0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x53, 0x10, 0x02, 0x2a, 0x31, 0x0a,
+	0x0a, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x4e,
+	0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x45, 0x4e, 0x49, 0x45,
+	0x4e, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x10, 0x02,
+	0x2a, 0x47, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15,
+	0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x4d, 0x46, 0x10, 0x01,
+	0x12, 0x09, 0x0a, 0x05, 0x53, 0x47, 0x57, 0x5f, 0x43, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43,
+	0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x32, 0xea, 0x09, 0x0a, 0x07, 0x50, 0x46,
+	0x43, 0x50, 0x53, 0x69, 0x6d, 0x12, 0x4b, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
+	0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x3a,
+	0x01, 0x2a, 0x12, 0x47, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x12,
+	0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x4d, 0x0a, 0x0c, 0x44,
+	0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x73, 0x61, 0x73,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x4b, 0x0a, 0x0b, 0x52, 0x65,
+	0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x61, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x52, 0x0a, 0x0d, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x32, 0x0c,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0x59, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x3a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x45, 0x0a, 0x08, 0x53, 0x65,
+	0x74, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74,
+	0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0e, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x3a, 0x01,
+	0x2a, 0x12, 0x5b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5c,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x7d, 0x12, 0x3f, 0x0a, 0x05,
+	0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22,
+	0x09, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x75, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x61, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x12, 0x50, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x66, 0x74, 0x65, 0x73, 0x74, 0x3a,
+	0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44,
+	0x61, 0x74, 0x61, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x50, 0x61,
+	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x70, 0x61, 0x74, 0x68,
+	0x3a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x4b, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x55, 0x50, 0x46, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x50, 0x46, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70,
+	0x66, 0x2f, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x3b, 0x61, 0x70, 0x69, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pfcpsim_proto_rawDescOnce sync.Once
+	file_pfcpsim_proto_rawDescData = file_pfcpsim_proto_rawDesc
+)
+
+func file_pfcpsim_proto_rawDescGZIP() []byte {
+	file_pfcpsim_proto_rawDescOnce.Do(func() {
+		file_pfcpsim_proto_rawDescData = protoimpl.X.CompressGZIP(file_pfcpsim_proto_rawDescData)
+	})
+	return file_pfcpsim_proto_rawDescData
+}
+
+var file_pfcpsim_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_pfcpsim_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_pfcpsim_proto_goTypes = []interface{}{
+	(FARAction)(0),                 // 0: api.FARAction
+	(HoldTimeDistribution)(0),      // 1: api.HoldTimeDistribution
+	(BitRateUnit)(0),               // 2: api.BitRateUnit
+	(DuplicateUEAddressPolicy)(0),  // 3: api.DuplicateUEAddressPolicy
+	(Strictness)(0),                // 4: api.Strictness
+	(NodeType)(0),                  // 5: api.NodeType
+	(*URRSpec)(nil),                // 6: api.URRSpec
+	(*MBSSpec)(nil),                // 7: api.MBSSpec
+	(*HoldTime)(nil),               // 8: api.HoldTime
+	(*CreateSessionRequest)(nil),   // 9: api.CreateSessionRequest
+	(*RuleIDs)(nil),                // 10: api.RuleIDs
+	(*TrafficSteering)(nil),        // 11: api.TrafficSteering
+	(*ModifySessionRequest)(nil),   // 12: api.ModifySessionRequest
+	(*ModificationStep)(nil),       // 13: api.ModificationStep
+	(*RuleLimits)(nil),             // 14: api.RuleLimits
+	(*TEIDRange)(nil),              // 15: api.TEIDRange
+	(*ConfigureRequest)(nil),       // 16: api.ConfigureRequest
+	(*DeleteSessionRequest)(nil),   // 17: api.DeleteSessionRequest
+	(*EmptyRequest)(nil),           // 18: api.EmptyRequest
+	(*Response)(nil),               // 19: api.Response
+	(*LatencyReport)(nil),          // 20: api.LatencyReport
+	(*RuleStatus)(nil),             // 21: api.RuleStatus
+	(*SessionRuleStatus)(nil),      // 22: api.SessionRuleStatus
+	(*SessionCountResponse)(nil),   // 23: api.SessionCountResponse
+	(*BatchFailure)(nil),           // 24: api.BatchFailure
+	(*GetSessionRequest)(nil),      // 25: api.GetSessionRequest
+	(*PDRInfo)(nil),                // 26: api.PDRInfo
+	(*FARInfo)(nil),                // 27: api.FARInfo
+	(*QERInfo)(nil),                // 28: api.QERInfo
+	(*SessionResponse)(nil),        // 29: api.SessionResponse
+	(*SessionMapping)(nil),         // 30: api.SessionMapping
+	(*SessionMappingResponse)(nil), // 31: api.SessionMappingResponse
+	(*SetDrainRequest)(nil),        // 32: api.SetDrainRequest
+	(*SetupRequest)(nil),           // 33: api.SetupRequest
+	(*SelfTestRequest)(nil),        // 34: api.SelfTestRequest
+	(*SelfTestStep)(nil),           // 35: api.SelfTestStep
+	(*SelfTestResponse)(nil),       // 36: api.SelfTestResponse
+	(*CheckDataPathRequest)(nil),   // 37: api.CheckDataPathRequest
+	(*CheckDataPathResponse)(nil),  // 38: api.CheckDataPathResponse
+	(*LoadControlInfo)(nil),        // 39: api.LoadControlInfo
+	(*OverloadControlInfo)(nil),    // 40: api.OverloadControlInfo
+	(*UPFLoadResponse)(nil),        // 41: api.UPFLoadResponse
+}
+var file_pfcpsim_proto_depIdxs = []int32{
+	1,  // 0: api.HoldTime.distribution:type_name -> api.HoldTimeDistribution
+	0,  // 1: api.CreateSessionRequest.uplinkDefaultAction:type_name -> api.FARAction
+	0,  // 2: api.CreateSessionRequest.downlinkDefaultAction:type_name -> api.FARAction
+	6,  // 3: api.CreateSessionRequest.urr:type_name -> api.URRSpec
+	2,  // 4: api.CreateSessionRequest.bitRateUnit:type_name -> api.BitRateUnit
+	8,  // 5: api.CreateSessionRequest.holdTime:type_name -> api.HoldTime
+	0,  // 6: api.CreateSessionRequest.initialDownlinkAction:type_name -> api.FARAction
+	7,  // 7: api.CreateSessionRequest.mbs:type_name -> api.MBSSpec
+	11, // 8: api.CreateSessionRequest.trafficSteering:type_name -> api.TrafficSteering
+	3,  // 9: api.CreateSessionRequest.duplicateUEAddressPolicy:type_name -> api.DuplicateUEAddressPolicy
+	10, // 10: api.CreateSessionRequest.ruleIDs:type_name -> api.RuleIDs
+	13, // 11: api.ModifySessionRequest.sequence:type_name -> api.ModificationStep
+	0,  // 12: api.ModificationStep.action:type_name -> api.FARAction
+	4,  // 13: api.ConfigureRequest.strictness:type_name -> api.Strictness
+	5,  // 14: api.ConfigureRequest.nodeType:type_name -> api.NodeType
+	14, // 15: api.ConfigureRequest.ruleLimits:type_name -> api.RuleLimits
+	15, // 16: api.ConfigureRequest.teidRange:type_name -> api.TEIDRange
+	22, // 17: api.Response.ruleStatus:type_name -> api.SessionRuleStatus
+	20, // 18: api.Response.latency:type_name -> api.LatencyReport
+	21, // 19: api.SessionRuleStatus.rules:type_name -> api.RuleStatus
+	26, // 20: api.SessionResponse.pdrs:type_name -> api.PDRInfo
+	27, // 21: api.SessionResponse.fars:type_name -> api.FARInfo
+	28, // 22: api.SessionResponse.qers:type_name -> api.QERInfo
+	30, // 23: api.SessionMappingResponse.sessions:type_name -> api.SessionMapping
+	16, // 24: api.SetupRequest.configure:type_name -> api.ConfigureRequest
+	9,  // 25: api.SetupRequest.sessions:type_name -> api.CreateSessionRequest
+	35, // 26: api.SelfTestResponse.steps:type_name -> api.SelfTestStep
+	39, // 27: api.UPFLoadResponse.load:type_name -> api.LoadControlInfo
+	40, // 28: api.UPFLoadResponse.overload:type_name -> api.OverloadControlInfo
+	16, // 29: api.PFCPSim.Configure:input_type -> api.ConfigureRequest
+	18, // 30: api.PFCPSim.Associate:input_type -> api.EmptyRequest
+	18, // 31: api.PFCPSim.Disassociate:input_type -> api.EmptyRequest
+	18, // 32: api.PFCPSim.ReAssociate:input_type -> api.EmptyRequest
+	9,  // 33: api.PFCPSim.CreateSession:input_type -> api.CreateSessionRequest
+	12, // 34: api.PFCPSim.ModifySession:input_type -> api.ModifySessionRequest
+	17, // 35: api.PFCPSim.DeleteSession:input_type -> api.DeleteSessionRequest
+	32, // 36: api.PFCPSim.SetDrain:input_type -> api.SetDrainRequest
+	18, // 37: api.PFCPSim.GetSessionCount:input_type -> api.EmptyRequest
+	25, // 38: api.PFCPSim.GetSession:input_type -> api.GetSessionRequest
+	33, // 39: api.PFCPSim.Setup:input_type -> api.SetupRequest
+	18, // 40: api.PFCPSim.GetSessionMapping:input_type -> api.EmptyRequest
+	34, // 41: api.PFCPSim.SelfTest:input_type -> api.SelfTestRequest
+	37, // 42: api.PFCPSim.CheckDataPath:input_type -> api.CheckDataPathRequest
+	18, // 43: api.PFCPSim.GetUPFLoad:input_type -> api.EmptyRequest
+	19, // 44: api.PFCPSim.Configure:output_type -> api.Response
+	19, // 45: api.PFCPSim.Associate:output_type -> api.Response
+	19, // 46: api.PFCPSim.Disassociate:output_type -> api.Response
+	19, // 47: api.PFCPSim.ReAssociate:output_type -> api.Response
+	19, // 48: api.PFCPSim.CreateSession:output_type -> api.Response
+	19, // 49: api.PFCPSim.ModifySession:output_type -> api.Response
+	19, // 50: api.PFCPSim.DeleteSession:output_type -> api.Response
+	19, // 51: api.PFCPSim.SetDrain:output_type -> api.Response
+	23, // 52: api.PFCPSim.GetSessionCount:output_type -> api.SessionCountResponse
+	29, // 53: api.PFCPSim.GetSession:output_type -> api.SessionResponse
+	19, // 54: api.PFCPSim.Setup:output_type -> api.Response
+	31, // 55: api.PFCPSim.GetSessionMapping:output_type -> api.SessionMappingResponse
+	36, // 56: api.PFCPSim.SelfTest:output_type -> api.SelfTestResponse
+	38, // 57: api.PFCPSim.CheckDataPath:output_type -> api.CheckDataPathResponse
+	41, // 58: api.PFCPSim.GetUPFLoad:output_type -> api.UPFLoadResponse
+	44, // [44:59] is the sub-list for method output_type
+	29, // [29:44] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_pfcpsim_proto_init() }
+func file_pfcpsim_proto_init() {
+	if File_pfcpsim_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pfcpsim_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*URRSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MBSSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HoldTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleIDs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficSteering); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifySessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModificationStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TEIDRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatencyReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionRuleStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionCountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PDRInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FARInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QERInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionMappingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDrainRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -556,8 +4234,8 @@ func file_pfcpsim_proto_init() {
 				return nil
 			}
 		}
-		file_pfcpsim_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifySessionRequest); i {
+		file_pfcpsim_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -568,8 +4246,8 @@ func file_pfcpsim_proto_init() {
 				return nil
 			}
 		}
-		file_pfcpsim_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigureRequest); i {
+		file_pfcpsim_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfTestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -580,8 +4258,8 @@ func file_pfcpsim_proto_init() {
 				return nil
 			}
 		}
-		file_pfcpsim_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSessionRequest); i {
+		file_pfcpsim_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfTestStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -592,8 +4270,8 @@ func file_pfcpsim_proto_init() {
 				return nil
 			}
 		}
-		file_pfcpsim_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyRequest); i {
+		file_pfcpsim_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfTestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -604,8 +4282,56 @@ func file_pfcpsim_proto_init() {
 				return nil
 			}
 		}
-		file_pfcpsim_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+		file_pfcpsim_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckDataPathRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckDataPathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadControlInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OverloadControlInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pfcpsim_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UPFLoadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -622,13 +4348,14 @@ func file_pfcpsim_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pfcpsim_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      6,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pfcpsim_proto_goTypes,
 		DependencyIndexes: file_pfcpsim_proto_depIdxs,
+		EnumInfos:         file_pfcpsim_proto_enumTypes,
 		MessageInfos:      file_pfcpsim_proto_msgTypes,
 	}.Build()
 	File_pfcpsim_proto = out.File
@@ -654,9 +4381,32 @@ type PFCPSimClient interface {
 	Associate(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Response, error)
 	// Disassociate perform teardown of association and disconnects from remote peer.
 	Disassociate(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Response, error)
+	// ReAssociate performs a disassociation, if associated, then a new association, once the association cooldown has elapsed.
+	ReAssociate(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Response, error)
 	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*Response, error)
 	ModifySession(ctx context.Context, in *ModifySessionRequest, opts ...grpc.CallOption) (*Response, error)
 	DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*Response, error)
+	// SetDrain starts or stops draining: while draining, CreateSession fails with UNAVAILABLE,
+	// whereas existing sessions can still be modified and deleted.
+	SetDrain(ctx context.Context, in *SetDrainRequest, opts ...grpc.CallOption) (*Response, error)
+	// GetSessionCount returns the number of active sessions and the association status.
+	GetSessionCount(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*SessionCountResponse, error)
+	// GetSession returns the details of an active session. Fails with NOT_FOUND if the session is not active.
+	// It is registered after GetSessionCount, whose REST path would otherwise match the one of GetSession.
+	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*SessionResponse, error)
+	// Setup configures the server, associates if not associated yet, and creates a batch of sessions in one call.
+	// A failed attempt is rolled back, deleting the sessions it created and tearing down the association it established,
+	// then attempted again up to the number of retries.
+	Setup(ctx context.Context, in *SetupRequest, opts ...grpc.CallOption) (*Response, error)
+	// GetSessionMapping returns the SEIDs and UE address of every active session, to correlate them with external data.
+	GetSessionMapping(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*SessionMappingResponse, error)
+	// SelfTest creates, modifies and deletes a session, reporting the outcome of each step.
+	// The session is deleted even if its modification fails.
+	SelfTest(ctx context.Context, in *SelfTestRequest, opts ...grpc.CallOption) (*SelfTestResponse, error)
+	// CheckDataPath sends a GTP-U Echo Request on the N3 path and reports whether it is up.
+	CheckDataPath(ctx context.Context, in *CheckDataPathRequest, opts ...grpc.CallOption) (*CheckDataPathResponse, error)
+	// GetUPFLoad returns the latest load and overload control information advertised by the UPF.
+	GetUPFLoad(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*UPFLoadResponse, error)
 }
 
 type pFCPSimClient struct {
@@ -694,6 +4444,15 @@ func (c *pFCPSimClient) Disassociate(ctx context.Context, in *EmptyRequest, opts
 	return out, nil
 }
 
+func (c *pFCPSimClient) ReAssociate(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/api.PFCPSim/ReAssociate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pFCPSimClient) CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := c.cc.Invoke(ctx, "/api.PFCPSim/CreateSession", in, out, opts...)
@@ -721,6 +4480,78 @@ func (c *pFCPSimClient) DeleteSession(ctx context.Context, in *DeleteSessionRequ
 	return out, nil
 }
 
+func (c *pFCPSimClient) SetDrain(ctx context.Context, in *SetDrainRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/api.PFCPSim/SetDrain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pFCPSimClient) GetSessionCount(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*SessionCountResponse, error) {
+	out := new(SessionCountResponse)
+	err := c.cc.Invoke(ctx, "/api.PFCPSim/GetSessionCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pFCPSimClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*SessionResponse, error) {
+	out := new(SessionResponse)
+	err := c.cc.Invoke(ctx, "/api.PFCPSim/GetSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pFCPSimClient) Setup(ctx context.Context, in *SetupRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/api.PFCPSim/Setup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pFCPSimClient) GetSessionMapping(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*SessionMappingResponse, error) {
+	out := new(SessionMappingResponse)
+	err := c.cc.Invoke(ctx, "/api.PFCPSim/GetSessionMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pFCPSimClient) SelfTest(ctx context.Context, in *SelfTestRequest, opts ...grpc.CallOption) (*SelfTestResponse, error) {
+	out := new(SelfTestResponse)
+	err := c.cc.Invoke(ctx, "/api.PFCPSim/SelfTest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pFCPSimClient) CheckDataPath(ctx context.Context, in *CheckDataPathRequest, opts ...grpc.CallOption) (*CheckDataPathResponse, error) {
+	out := new(CheckDataPathResponse)
+	err := c.cc.Invoke(ctx, "/api.PFCPSim/CheckDataPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pFCPSimClient) GetUPFLoad(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*UPFLoadResponse, error) {
+	out := new(UPFLoadResponse)
+	err := c.cc.Invoke(ctx, "/api.PFCPSim/GetUPFLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PFCPSimServer is the server API for PFCPSim service.
 type PFCPSimServer interface {
 	Configure(context.Context, *ConfigureRequest) (*Response, error)
@@ -728,9 +4559,32 @@ type PFCPSimServer interface {
 	Associate(context.Context, *EmptyRequest) (*Response, error)
 	// Disassociate perform teardown of association and disconnects from remote peer.
 	Disassociate(context.Context, *EmptyRequest) (*Response, error)
+	// ReAssociate performs a disassociation, if associated, then a new association, once the association cooldown has elapsed.
+	ReAssociate(context.Context, *EmptyRequest) (*Response, error)
 	CreateSession(context.Context, *CreateSessionRequest) (*Response, error)
 	ModifySession(context.Context, *ModifySessionRequest) (*Response, error)
 	DeleteSession(context.Context, *DeleteSessionRequest) (*Response, error)
+	// SetDrain starts or stops draining: while draining, CreateSession fails with UNAVAILABLE,
+	// whereas existing sessions can still be modified and deleted.
+	SetDrain(context.Context, *SetDrainRequest) (*Response, error)
+	// GetSessionCount returns the number of active sessions and the association status.
+	GetSessionCount(context.Context, *EmptyRequest) (*SessionCountResponse, error)
+	// GetSession returns the details of an active session. Fails with NOT_FOUND if the session is not active.
+	// It is registered after GetSessionCount, whose REST path would otherwise match the one of GetSession.
+	GetSession(context.Context, *GetSessionRequest) (*SessionResponse, error)
+	// Setup configures the server, associates if not associated yet, and creates a batch of sessions in one call.
+	// A failed attempt is rolled back, deleting the sessions it created and tearing down the association it established,
+	// then attempted again up to the number of retries.
+	Setup(context.Context, *SetupRequest) (*Response, error)
+	// GetSessionMapping returns the SEIDs and UE address of every active session, to correlate them with external data.
+	GetSessionMapping(context.Context, *EmptyRequest) (*SessionMappingResponse, error)
+	// SelfTest creates, modifies and deletes a session, reporting the outcome of each step.
+	// The session is deleted even if its modification fails.
+	SelfTest(context.Context, *SelfTestRequest) (*SelfTestResponse, error)
+	// CheckDataPath sends a GTP-U Echo Request on the N3 path and reports whether it is up.
+	CheckDataPath(context.Context, *CheckDataPathRequest) (*CheckDataPathResponse, error)
+	// GetUPFLoad returns the latest load and overload control information advertised by the UPF.
+	GetUPFLoad(context.Context, *EmptyRequest) (*UPFLoadResponse, error)
 }
 
 // UnimplementedPFCPSimServer can be embedded to have forward compatible implementations.
@@ -746,6 +4600,9 @@ func (*UnimplementedPFCPSimServer) Associate(context.Context, *EmptyRequest) (*R
 func (*UnimplementedPFCPSimServer) Disassociate(context.Context, *EmptyRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Disassociate not implemented")
 }
+func (*UnimplementedPFCPSimServer) ReAssociate(context.Context, *EmptyRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReAssociate not implemented")
+}
 func (*UnimplementedPFCPSimServer) CreateSession(context.Context, *CreateSessionRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
 }
@@ -755,6 +4612,30 @@ func (*UnimplementedPFCPSimServer) ModifySession(context.Context, *ModifySession
 func (*UnimplementedPFCPSimServer) DeleteSession(context.Context, *DeleteSessionRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
 }
+func (*UnimplementedPFCPSimServer) SetDrain(context.Context, *SetDrainRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDrain not implemented")
+}
+func (*UnimplementedPFCPSimServer) GetSessionCount(context.Context, *EmptyRequest) (*SessionCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionCount not implemented")
+}
+func (*UnimplementedPFCPSimServer) GetSession(context.Context, *GetSessionRequest) (*SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSession not implemented")
+}
+func (*UnimplementedPFCPSimServer) Setup(context.Context, *SetupRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Setup not implemented")
+}
+func (*UnimplementedPFCPSimServer) GetSessionMapping(context.Context, *EmptyRequest) (*SessionMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionMapping not implemented")
+}
+func (*UnimplementedPFCPSimServer) SelfTest(context.Context, *SelfTestRequest) (*SelfTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfTest not implemented")
+}
+func (*UnimplementedPFCPSimServer) CheckDataPath(context.Context, *CheckDataPathRequest) (*CheckDataPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDataPath not implemented")
+}
+func (*UnimplementedPFCPSimServer) GetUPFLoad(context.Context, *EmptyRequest) (*UPFLoadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUPFLoad not implemented")
+}
 
 func RegisterPFCPSimServer(s *grpc.Server, srv PFCPSimServer) {
 	s.RegisterService(&_PFCPSim_serviceDesc, srv)
@@ -814,6 +4695,24 @@ func _PFCPSim_Disassociate_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PFCPSim_ReAssociate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PFCPSimServer).ReAssociate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PFCPSim/ReAssociate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PFCPSimServer).ReAssociate(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PFCPSim_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSessionRequest)
 	if err := dec(in); err != nil {
@@ -868,6 +4767,150 @@ func _PFCPSim_DeleteSession_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PFCPSim_SetDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PFCPSimServer).SetDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PFCPSim/SetDrain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PFCPSimServer).SetDrain(ctx, req.(*SetDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PFCPSim_GetSessionCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PFCPSimServer).GetSessionCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PFCPSim/GetSessionCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PFCPSimServer).GetSessionCount(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PFCPSim_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PFCPSimServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PFCPSim/GetSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PFCPSimServer).GetSession(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PFCPSim_Setup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PFCPSimServer).Setup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PFCPSim/Setup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PFCPSimServer).Setup(ctx, req.(*SetupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PFCPSim_GetSessionMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PFCPSimServer).GetSessionMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PFCPSim/GetSessionMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PFCPSimServer).GetSessionMapping(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PFCPSim_SelfTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelfTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PFCPSimServer).SelfTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PFCPSim/SelfTest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PFCPSimServer).SelfTest(ctx, req.(*SelfTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PFCPSim_CheckDataPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDataPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PFCPSimServer).CheckDataPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PFCPSim/CheckDataPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PFCPSimServer).CheckDataPath(ctx, req.(*CheckDataPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PFCPSim_GetUPFLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PFCPSimServer).GetUPFLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PFCPSim/GetUPFLoad",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PFCPSimServer).GetUPFLoad(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PFCPSim_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.PFCPSim",
 	HandlerType: (*PFCPSimServer)(nil),
@@ -884,6 +4927,10 @@ var _PFCPSim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Disassociate",
 			Handler:    _PFCPSim_Disassociate_Handler,
 		},
+		{
+			MethodName: "ReAssociate",
+			Handler:    _PFCPSim_ReAssociate_Handler,
+		},
 		{
 			MethodName: "CreateSession",
 			Handler:    _PFCPSim_CreateSession_Handler,
@@ -896,6 +4943,38 @@ var _PFCPSim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSession",
 			Handler:    _PFCPSim_DeleteSession_Handler,
 		},
+		{
+			MethodName: "SetDrain",
+			Handler:    _PFCPSim_SetDrain_Handler,
+		},
+		{
+			MethodName: "GetSessionCount",
+			Handler:    _PFCPSim_GetSessionCount_Handler,
+		},
+		{
+			MethodName: "GetSession",
+			Handler:    _PFCPSim_GetSession_Handler,
+		},
+		{
+			MethodName: "Setup",
+			Handler:    _PFCPSim_Setup_Handler,
+		},
+		{
+			MethodName: "GetSessionMapping",
+			Handler:    _PFCPSim_GetSessionMapping_Handler,
+		},
+		{
+			MethodName: "SelfTest",
+			Handler:    _PFCPSim_SelfTest_Handler,
+		},
+		{
+			MethodName: "CheckDataPath",
+			Handler:    _PFCPSim_CheckDataPath_Handler,
+		},
+		{
+			MethodName: "GetUPFLoad",
+			Handler:    _PFCPSim_GetUPFLoad_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pfcpsim.proto",
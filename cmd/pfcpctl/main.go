@@ -20,11 +20,20 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	// Set server address and configure other parameters
-	config.ProcessGlobalOptions()
+	// Set server address and configure other parameters, once parsed, before executing the command
+	parser.CommandHandler = func(command flags.Commander, args []string) error {
+		config.ProcessGlobalOptions()
+
+		if command == nil {
+			return nil
+		}
+
+		return command.Execute(args)
+	}
 
 	commands.RegisterServiceCommands(parser)
 	commands.RegisterSessionCommands(parser)
+	commands.RegisterWatchCommand(parser)
 
 	_, err = parser.ParseArgs(os.Args[1:])
 	if err != nil {
@@ -4,15 +4,20 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"sync"
 	"syscall"
 
 	pb "github.com/infinitydon/pfcpsim/api"
 	"github.com/infinitydon/pfcpsim/internal/pfcpsim"
+	pfcpsimLib "github.com/infinitydon/pfcpsim/pkg/pfcpsim"
 	"github.com/pborman/getopt/v2"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -22,13 +27,13 @@ const (
 	defaultgRPCServerPort = "54321"
 )
 
-func startServer(apiDoneChannel chan bool, iFace string, port string, group *sync.WaitGroup) {
+func startServer(apiDoneChannel chan bool, iFace string, port string, group *sync.WaitGroup, opts ...grpc.ServerOption) {
 	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%v", port))
 	if err != nil {
 		log.Fatalf("API gRPC Server failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(opts...)
 
 	pb.RegisterPFCPSimServer(grpcServer, pfcpsim.NewPFCPSimService(iFace))
 
@@ -50,10 +55,74 @@ func startServer(apiDoneChannel chan bool, iFace string, port string, group *syn
 	group.Done()
 }
 
+// startRESTServer starts a REST gateway proxying requests to the gRPC server listening on grpcPort.
+func startRESTServer(apiDoneChannel chan bool, grpcPort string, restPort string, group *sync.WaitGroup) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler, err := pfcpsim.NewRESTHandler(ctx, fmt.Sprintf("localhost:%v", grpcPort))
+	if err != nil {
+		log.Fatalf("REST gateway failed to start: %v", err)
+	}
+
+	restServer := &http.Server{
+		Addr:    fmt.Sprintf("0.0.0.0:%v", restPort),
+		Handler: handler,
+	}
+
+	go func() {
+		if err := restServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("REST gateway failed to listen: %v", err)
+		}
+	}()
+
+	log.Infof("REST gateway listening on port %v", restPort)
+
+	<-apiDoneChannel
+
+	restServer.Close()
+	log.Warnf("Stopping REST gateway")
+
+	group.Done()
+}
+
 func main() {
 	port := getopt.StringLong("port", 'p', defaultgRPCServerPort, "the gRPC Server port to listen")
 	iFaceName := getopt.StringLong("interface", 'i', "", "Defines the local address. If left blank,"+
-		" the IP will be taken from the first non-loopback interface")
+		" the address the OS selects to reach the remote peer is used")
+	localAddress := getopt.StringLong("local-address", 0, "", "The local address used with the remote peer."+
+		" It takes precedence over --interface")
+
+	assumeAssociated := getopt.BoolLong("assume-associated", 0, "Test mode: session operations build and validate"+
+		" PFCP messages, without sending them to any remote peer")
+
+	autoAssociate := getopt.BoolLong("auto-associate", 0, "Session operations finding no association with the configured"+
+		" remote peer associate first, then proceed")
+
+	mtu := getopt.IntLong("mtu", 0, pfcpsimLib.DefaultMTU, "Maximum size of a PFCP message received from the remote peer")
+	readBufferSize := getopt.IntLong("read-buffer-size", 0, 0, "Size of the PFCP socket receive buffer. If left blank, the system default is used")
+	writeBufferSize := getopt.IntLong("write-buffer-size", 0, 0, "Size of the PFCP socket send buffer. If left blank, the system default is used")
+
+	bindLocalAddress := getopt.BoolLong("bind-local-address", 0, "Bind the PFCP socket to the local address of --interface,"+
+		" e.g. to send PFCP messages through an interface protected by IPsec")
+
+	maxSessionRate := getopt.StringLong("max-session-rate", 0, "0", "Server-wide maximum number of sessions established per second,"+
+		" regardless of the clients requests. 0 means unlimited")
+
+	maxRecvMsgSize := getopt.IntLong("max-recv-msg-size", 0, 0, "Maximum size in bytes of a gRPC message received from the clients."+
+		" If left blank, the gRPC default (4MB) is used")
+	maxSendMsgSize := getopt.IntLong("max-send-msg-size", 0, 0, "Maximum size in bytes of a gRPC message sent to the clients."+
+		" If left blank, the gRPC default is used")
+
+	auditLog := getopt.StringLong("audit-log", 0, "", "If set, the file every RPC call is recorded to, with its caller, parameters and result")
+	auditRedact := getopt.ListLong("audit-redact", 0, "Comma-separated request fields whose value is redacted"+
+		" in the audit log (e.g. ueAddressPool)")
+
+	logRPCs := getopt.BoolLong("log-rpcs", 0, "Log every RPC call with its caller, result code and duration")
+	noPanicRecovery := getopt.BoolLong("no-panic-recovery", 0, "Let a panic while handling a RPC crash the server,"+
+		" instead of failing the RPC with INTERNAL")
+
+	restPort := getopt.StringLong("rest-port", 0, "", "If set, the port of a REST gateway exposing the gRPC API as REST/JSON")
 
 	optHelp := getopt.BoolLong("help", 0, "Help")
 
@@ -63,6 +132,48 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *assumeAssociated {
+		log.Warn("Assume-associated mode enabled: PFCP messages will not be sent to any remote peer")
+		pfcpsim.SetAssumeAssociatedMode(true)
+	}
+
+	pfcpsim.SetAutoAssociateMode(*autoAssociate)
+	pfcpsim.SetLocalAddress(*localAddress)
+
+	pfcpsim.SetSocketOptions(pfcpsimLib.SocketOptions{
+		MTU:              *mtu,
+		ReadBufferSize:   *readBufferSize,
+		WriteBufferSize:  *writeBufferSize,
+		BindLocalAddress: *bindLocalAddress,
+	})
+
+	sessionRate, err := strconv.ParseFloat(*maxSessionRate, 64)
+	if err != nil || sessionRate < 0 {
+		log.Fatalf("Invalid maximum session rate: %v", *maxSessionRate)
+	}
+
+	pfcpsim.SetMaxSessionCreationRate(sessionRate)
+
+	serverOpts := pfcpsim.MessageSizeServerOptions(*maxRecvMsgSize, *maxSendMsgSize)
+
+	var interceptors []grpc.UnaryServerInterceptor
+
+	if *auditLog != "" {
+		auditFile, err := os.OpenFile(*auditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			log.Fatalf("Could not open audit log: %v", err)
+		}
+		defer auditFile.Close()
+
+		interceptors = append(interceptors, pfcpsim.NewAuditInterceptor(auditFile, *auditRedact))
+		log.Infof("Recording RPC calls to audit log %v", *auditLog)
+	}
+
+	serverOpts = append(serverOpts, pfcpsim.UnaryInterceptorChain(pfcpsim.InterceptorOptions{
+		Recovery: !*noPanicRecovery,
+		Logging:  *logRPCs,
+	}, interceptors...))
+
 	// control channels, they are only closed when the goroutine needs to be terminated
 	doneChannel := make(chan bool)
 
@@ -78,9 +189,16 @@ func main() {
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 
-	go startServer(doneChannel, *iFaceName, *port, &wg)
+	go startServer(doneChannel, *iFaceName, *port, &wg, serverOpts...)
 	log.Debugf("Started API gRPC Service")
 
+	if *restPort != "" {
+		wg.Add(1)
+
+		go startRESTServer(doneChannel, *port, *restPort, &wg)
+		log.Debugf("Started REST gateway")
+	}
+
 	wg.Wait()
 
 	defer func() {
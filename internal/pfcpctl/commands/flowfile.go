@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+)
+
+const (
+	flowFileCommentPrefix     = "#"
+	flowFileDefaultAction     = "allow"
+	flowFileDefaultPrecedence = "100"
+)
+
+// readFlowFile converts the flows defined in the file at path to application filters.
+// See parseFlowFile for the file format.
+func readFlowFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return parseFlowFile(f)
+}
+
+// parseFlowFile converts flow definitions to application filters, which are validated by the server.
+// Each line defines a flow as whitespace-separated fields:
+//
+//	<protocol> <IPv4 address or prefix | any> <port | lower-upper | any> [allow | deny | drop] [precedence]
+//
+// Action and precedence default to allow and 100. Empty lines and lines starting with '#' are ignored.
+func parseFlowFile(r io.Reader) ([]string, error) {
+	var filters []string
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, flowFileCommentPrefix) {
+			continue
+		}
+
+		filter, err := flowToAppFilter(strings.Fields(line))
+		if err != nil {
+			return nil, fmt.Errorf("invalid flow at line %v: %w", lineNumber, err)
+		}
+
+		filters = append(filters, filter)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
+// flowToAppFilter converts the fields of a flow definition to an application filter.
+func flowToAppFilter(fields []string) (string, error) {
+	if len(fields) < 3 || len(fields) > 5 {
+		return "", fmt.Errorf("expected 3 to 5 fields, got %v", len(fields))
+	}
+
+	proto, address, ports := strings.ToLower(fields[0]), fields[1], fields[2]
+	action, precedence := flowFileDefaultAction, flowFileDefaultPrecedence
+
+	if len(fields) > 3 {
+		action = fields[3]
+	}
+
+	if len(fields) > 4 {
+		precedence = fields[4]
+	}
+
+	if address != "any" && !strings.Contains(address, "/") {
+		// a single host
+		if ip := net.ParseIP(address); ip == nil || ip.To4() == nil {
+			return "", fmt.Errorf("invalid IPv4 address %v", address)
+		}
+
+		address = fmt.Sprintf("%v/%v", address, net.IPv4len*8)
+	}
+
+	if ports != "any" && !strings.Contains(ports, "-") {
+		// a single port
+		ports = fmt.Sprintf("%v-%v", ports, ports)
+	}
+
+	return strings.Join([]string{proto, address, ports, action, precedence}, ":"), nil
+}
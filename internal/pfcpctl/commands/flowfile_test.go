@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFlowFile(t *testing.T) {
+	flows := `# flows captured on N6
+udp 10.0.0.1 53
+tcp 192.168.0.0/16 80-88 deny 200
+
+ip any any
+UDP 10.0.0.2 443 allow 10
+`
+
+	filters, err := parseFlowFile(strings.NewReader(flows))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"udp:10.0.0.1/32:53-53:allow:100",
+		"tcp:192.168.0.0/16:80-88:deny:200",
+		"ip:any:any:allow:100",
+		"udp:10.0.0.2/32:443-443:allow:10",
+	}, filters)
+
+	// the resulting filters are validated by the server
+	for _, invalidFlows := range []string{
+		"udp 10.0.0.1",
+		"udp 10.0.0.300 53",
+		"udp 2001:db8::1 53",
+		"udp 10.0.0.1 53 allow 100 extra",
+	} {
+		_, err = parseFlowFile(strings.NewReader(invalidFlows))
+		require.Error(t, err, invalidFlows)
+	}
+}
@@ -4,6 +4,14 @@
 package commands
 
 import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
 	pb "github.com/infinitydon/pfcpsim/api"
 	"github.com/infinitydon/pfcpsim/internal/pfcpctl/config"
 	log "github.com/sirupsen/logrus"
@@ -15,8 +23,18 @@ var conn *grpc.ClientConn
 
 func connect() pb.PFCPSimClient {
 	// Create an insecure gRPC Channel
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	if config.GlobalConfig.MaxRecvMsgSize != 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(config.GlobalConfig.MaxRecvMsgSize)))
+	}
+
+	if config.GlobalConfig.MaxSendMsgSize != 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(config.GlobalConfig.MaxSendMsgSize)))
+	}
+
 	var err error
-	conn, err = grpc.Dial(config.GlobalConfig.Server, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err = grpc.Dial(config.GlobalConfig.Server, opts...)
 	if err != nil {
 		log.Fatalf("Error dialing %v: %v", config.GlobalConfig.Server, err)
 	}
@@ -28,8 +46,188 @@ func validateArgs(args *commonArgs) {
 
 }
 
+// toFARAction converts the action provided through command line to a FARAction.
+// Returns ACTION_UNSPECIFIED if action is empty.
+func toFARAction(action string) pb.FARAction {
+	return pb.FARAction(pb.FARAction_value[strings.ToUpper(action)])
+}
+
+// toURRSpec converts the measurement methods provided through command line to a URRSpec.
+// Returns nil if no measurement method is provided.
+func toURRSpec(methods []string) *pb.URRSpec {
+	if len(methods) == 0 {
+		return nil
+	}
+
+	spec := &pb.URRSpec{}
+
+	for _, method := range methods {
+		switch method {
+		case "volume":
+			spec.Volume = true
+		case "duration":
+			spec.Duration = true
+		case "event":
+			spec.Event = true
+		}
+	}
+
+	return spec
+}
+
 func disconnect() {
 	if conn != nil {
 		conn.Close()
 	}
 }
+
+// toHoldTime converts the hold time provided through command line to a HoldTime.
+// Returns nil if no hold time is provided.
+func toHoldTime(holdTime time.Duration, distribution string) *pb.HoldTime {
+	if holdTime == 0 {
+		return nil
+	}
+
+	return &pb.HoldTime{
+		DurationMs:   uint32(holdTime.Milliseconds()),
+		Distribution: pb.HoldTimeDistribution(pb.HoldTimeDistribution_value[strings.ToUpper(distribution)]),
+	}
+}
+
+// toMBSSpec converts the MBS addresses provided through command line to a MBSSpec.
+// Returns nil if no multicast address is provided.
+func toMBSSpec(multicastAddress string, sourceAddress string) *pb.MBSSpec {
+	if multicastAddress == "" {
+		return nil
+	}
+
+	return &pb.MBSSpec{
+		MulticastAddress: multicastAddress,
+		SourceAddress:    sourceAddress,
+	}
+}
+
+// toTrafficSteering converts the Traffic Steering Policy Identifiers provided through command line to a TrafficSteering.
+// Returns nil if no identifier is provided.
+func toTrafficSteering(uplinkPolicyID string, downlinkPolicyID string) *pb.TrafficSteering {
+	if uplinkPolicyID == "" && downlinkPolicyID == "" {
+		return nil
+	}
+
+	return &pb.TrafficSteering{
+		UplinkPolicyID:   uplinkPolicyID,
+		DownlinkPolicyID: downlinkPolicyID,
+	}
+}
+
+// toTEIDRange converts the TEID range provided through command line to a TEIDRange.
+// Returns nil if no bound is provided.
+func toTEIDRange(min uint32, max uint32) *pb.TEIDRange {
+	if min == 0 && max == 0 {
+		return nil
+	}
+
+	return &pb.TEIDRange{
+		Min: min,
+		Max: max,
+	}
+}
+
+// toModificationSequence converts the modification steps provided through command line, as '{forward | buffer}[:<delay>]',
+// to ModificationSteps. Returns error if a step is not valid.
+func toModificationSequence(steps []string) ([]*pb.ModificationStep, error) {
+	var sequence []*pb.ModificationStep
+
+	for _, step := range steps {
+		fields := strings.SplitN(step, ":", 2)
+
+		action := toFARAction(fields[0])
+		if action != pb.FARAction_FORWARD && action != pb.FARAction_BUFFER {
+			return nil, fmt.Errorf("step %v: action must be forward or buffer", step)
+		}
+
+		var delay time.Duration
+
+		if len(fields) == 2 {
+			var err error
+
+			delay, err = time.ParseDuration(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("step %v: %v", step, err)
+			}
+		}
+
+		sequence = append(sequence, &pb.ModificationStep{
+			Action:  action,
+			DelayMs: uint32(delay.Milliseconds()),
+		})
+	}
+
+	return sequence, nil
+}
+
+// toRuleIDs converts the explicit rule IDs provided through command line, as
+// '<uplink-pdr>:<downlink-pdr>:<uplink-far>:<downlink-far>[:<uplink-qer>:<downlink-qer>]', to RuleIDs.
+// Returns error if rule IDs are not valid.
+func toRuleIDs(flows []string) ([]*pb.RuleIDs, error) {
+	var ruleIDs []*pb.RuleIDs
+
+	for _, flow := range flows {
+		fields := strings.Split(flow, ":")
+		if len(fields) != 4 && len(fields) != 6 {
+			return nil, fmt.Errorf("rule IDs %v: 4 or 6 IDs are expected", flow)
+		}
+
+		ids := make([]uint32, 6)
+
+		for i, field := range fields {
+			id, err := strconv.ParseUint(field, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("rule IDs %v: %v", flow, err)
+			}
+
+			ids[i] = uint32(id)
+		}
+
+		ruleIDs = append(ruleIDs, &pb.RuleIDs{
+			UplinkPDRID:   ids[0],
+			DownlinkPDRID: ids[1],
+			UplinkFARID:   ids[2],
+			DownlinkFARID: ids[3],
+			UplinkQERID:   ids[4],
+			DownlinkQERID: ids[5],
+		})
+	}
+
+	return ruleIDs, nil
+}
+
+// sessionMappingHeader are the columns of the session mapping.
+var sessionMappingHeader = []string{"session ID", "local SEID", "peer SEID", "UE address"}
+
+// writeSessionMapping writes the session mappings to w, either as a table or as CSV.
+// SEIDs are written in hexadecimal, as displayed by packet analyzers.
+func writeSessionMapping(w io.Writer, mappings []*pb.SessionMapping, format string) error {
+	rows := [][]string{sessionMappingHeader}
+
+	for _, mapping := range mappings {
+		rows = append(rows, []string{
+			strconv.Itoa(int(mapping.SessionID)),
+			fmt.Sprintf("%#x", mapping.LocalSEID),
+			fmt.Sprintf("%#x", mapping.PeerSEID),
+			mapping.UeAddress,
+		})
+	}
+
+	if format == "csv" {
+		return csv.NewWriter(w).WriteAll(rows)
+	}
+
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	for _, row := range rows {
+		fmt.Fprintln(table, strings.Join(row, "\t"))
+	}
+
+	return table.Flush()
+}
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package commands
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	pb "github.com/infinitydon/pfcpsim/api"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+)
+
+// junitSuiteName is the name of the test suite of the JUnit reports.
+const junitSuiteName = "pfcpsim"
+
+// sessionStep is the step between the IDs of the sessions of a batch, as used by the server.
+const sessionStep = 10
+
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+
+	duration time.Duration
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// newJUnitTestCase returns the test case of an operation, failed if err is not nil.
+func newJUnitTestCase(className string, name string, duration time.Duration, err error) junitTestCase {
+	testCase := junitTestCase{
+		Name:      name,
+		ClassName: className,
+		Time:      formatJUnitTime(duration),
+		duration:  duration,
+	}
+
+	if err != nil {
+		testCase.Failure = &junitFailure{Message: err.Error()}
+	}
+
+	return testCase
+}
+
+// selfTestToJUnit returns a test case for each step of the self-test.
+func selfTestToJUnit(res *pb.SelfTestResponse) []junitTestCase {
+	var testCases []junitTestCase
+
+	for _, step := range res.Steps {
+		var err error
+		if !step.Passed {
+			err = errors.New(step.Error)
+		}
+
+		testCases = append(testCases, newJUnitTestCase("selftest", step.Name, time.Duration(step.DurationMs)*time.Millisecond, err))
+	}
+
+	return testCases
+}
+
+// sessionBatchToJUnit returns a test case for each of the count sessions of a batch operation starting at baseID,
+// which took duration and returned err. If err carries a BatchFailure detail, the sessions before the failed one
+// passed, and the ones after it are reported as skipped, as they were not processed. Otherwise, all the sessions
+// failed with err. The duration of the batch is evenly split among the sessions.
+func sessionBatchToJUnit(operation string, baseID int, count int, duration time.Duration, err error) []junitTestCase {
+	var failure *pb.BatchFailure
+
+	for _, detail := range status.Convert(err).Details() {
+		if f, ok := detail.(*pb.BatchFailure); ok {
+			failure = f
+		}
+	}
+
+	testCases := make([]junitTestCase, 0, count)
+
+	for i := 0; i < count; i++ {
+		id := baseID + i*sessionStep
+		name := fmt.Sprintf("%v session %v (ID %v)", operation, i+1, id)
+
+		var sessionErr error
+
+		switch {
+		case failure != nil && id == int(failure.FailedSessionID):
+			sessionErr = errors.New(failure.Cause)
+		case failure != nil && id > int(failure.FailedSessionID):
+			testCase := newJUnitTestCase("session", name, 0, nil)
+			testCase.Skipped = &junitSkipped{
+				Message: fmt.Sprintf("Not processed, as the session with ID %v failed", failure.FailedSessionID),
+			}
+
+			testCases = append(testCases, testCase)
+
+			continue
+		case failure == nil && err != nil:
+			sessionErr = errors.New(status.Convert(err).Message())
+		}
+
+		testCases = append(testCases, newJUnitTestCase("session", name, duration/time.Duration(count), sessionErr))
+	}
+
+	return testCases
+}
+
+// formatJUnitTime formats d as seconds, the unit of JUnit durations.
+func formatJUnitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// encodeJUnitReport writes the JUnit XML report of testCases to w.
+func encodeJUnitReport(w io.Writer, testCases []junitTestCase) error {
+	suite := junitTestSuite{
+		Name:      junitSuiteName,
+		Tests:     len(testCases),
+		TestCases: testCases,
+	}
+
+	var total time.Duration
+
+	for _, testCase := range testCases {
+		total += testCase.duration
+
+		if testCase.Failure != nil {
+			suite.Failures++
+		}
+
+		if testCase.Skipped != nil {
+			suite.Skipped++
+		}
+	}
+
+	suite.Time = formatJUnitTime(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	return encoder.Encode(suite)
+}
+
+// writeJUnitReport writes the JUnit XML report of testCases to the file at path, if not empty.
+// Failing to write the report is logged, but does not make the command fail.
+func writeJUnitReport(path string, testCases ...junitTestCase) {
+	if path == "" {
+		return
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		log.Errorf("Error while creating JUnit report %v: %v", path, err)
+		return
+	}
+	defer f.Close()
+
+	if err := encodeJUnitReport(f, testCases); err != nil {
+		log.Errorf("Error while writing JUnit report %v: %v", path, err)
+	}
+}
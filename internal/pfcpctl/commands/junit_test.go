@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package commands
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/infinitydon/pfcpsim/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEncodeJUnitReport(t *testing.T) {
+	testCases := selfTestToJUnit(&pb.SelfTestResponse{
+		Steps: []*pb.SelfTestStep{
+			{Name: "create", Passed: true, DurationMs: 1500},
+			{Name: "modify", Passed: false, DurationMs: 20, Error: "Invalid Cause from response"},
+			{Name: "delete", Passed: true, DurationMs: 10},
+		},
+	})
+	testCases = append(testCases, newJUnitTestCase("session", "create", 30*time.Millisecond, errors.New("Server is not associated")))
+
+	out := &bytes.Buffer{}
+	require.NoError(t, encodeJUnitReport(out, testCases))
+
+	var suite junitTestSuite
+	require.NoError(t, xml.Unmarshal(out.Bytes(), &suite))
+
+	require.Equal(t, junitSuiteName, suite.Name)
+	require.Equal(t, 4, suite.Tests)
+	require.Equal(t, 2, suite.Failures)
+	require.Equal(t, "1.560", suite.Time)
+	require.Len(t, suite.TestCases, 4)
+
+	require.Equal(t, "selftest", suite.TestCases[0].ClassName)
+	require.Equal(t, "create", suite.TestCases[0].Name)
+	require.Equal(t, "1.500", suite.TestCases[0].Time)
+	require.Nil(t, suite.TestCases[0].Failure)
+
+	require.NotNil(t, suite.TestCases[1].Failure)
+	require.Equal(t, "Invalid Cause from response", suite.TestCases[1].Failure.Message)
+
+	require.Equal(t, "session", suite.TestCases[3].ClassName)
+	require.NotNil(t, suite.TestCases[3].Failure)
+	require.Equal(t, "Server is not associated", suite.TestCases[3].Failure.Message)
+}
+
+func TestSessionBatchToJUnitPartialFailure(t *testing.T) {
+	// the third session of a batch of 4, starting at baseID 1, is rejected by the UPF
+	st, err := status.New(codes.Aborted, "2 sessions succeeded; session 3 (ID 21) failed: Invalid Cause from response").
+		WithDetails(&pb.BatchFailure{Succeeded: 2, FailedIndex: 3, FailedSessionID: 21, Cause: "Invalid Cause from response"})
+	require.NoError(t, err)
+
+	out := &bytes.Buffer{}
+	require.NoError(t, encodeJUnitReport(out, sessionBatchToJUnit("create", 1, 4, 400*time.Millisecond, st.Err())))
+
+	var suite junitTestSuite
+	require.NoError(t, xml.Unmarshal(out.Bytes(), &suite))
+
+	require.Equal(t, 4, suite.Tests)
+	require.Equal(t, 1, suite.Failures)
+	require.Equal(t, 1, suite.Skipped)
+	require.Len(t, suite.TestCases, 4)
+
+	require.Equal(t, "create session 1 (ID 1)", suite.TestCases[0].Name)
+	require.Equal(t, "0.100", suite.TestCases[0].Time)
+	require.Nil(t, suite.TestCases[0].Failure)
+	require.Equal(t, "create session 2 (ID 11)", suite.TestCases[1].Name)
+	require.Nil(t, suite.TestCases[1].Failure)
+
+	require.Equal(t, "create session 3 (ID 21)", suite.TestCases[2].Name)
+	require.NotNil(t, suite.TestCases[2].Failure)
+	require.Equal(t, "Invalid Cause from response", suite.TestCases[2].Failure.Message)
+
+	require.Equal(t, "create session 4 (ID 31)", suite.TestCases[3].Name)
+	require.Nil(t, suite.TestCases[3].Failure)
+	require.NotNil(t, suite.TestCases[3].Skipped)
+
+	// without batch progress, e.g. if the server is not associated, all the sessions fail
+	testCases := sessionBatchToJUnit("delete", 1, 2, 0, status.Error(codes.Aborted, "Server is not associated"))
+	require.Len(t, testCases, 2)
+
+	for _, testCase := range testCases {
+		require.NotNil(t, testCase.Failure)
+		require.Equal(t, "Server is not associated", testCase.Failure.Message)
+	}
+
+	// all the sessions pass on success
+	for _, testCase := range sessionBatchToJUnit("modify", 5, 3, time.Second, nil) {
+		require.Nil(t, testCase.Failure)
+		require.Nil(t, testCase.Skipped)
+	}
+}
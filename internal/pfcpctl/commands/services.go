@@ -5,6 +5,8 @@ package commands
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 	pb "github.com/infinitydon/pfcpsim/api"
@@ -13,15 +15,62 @@ import (
 
 type associate struct{}
 type disassociate struct{}
+type reassociate struct{}
+type upfLoad struct{}
+type drain struct {
+	Stop bool `long:"stop" description:"Stop draining, accepting new sessions again"`
+}
+type setup struct {
+	commonArgs
+	RemotePeerAddress  string        `short:"r" long:"remote-peer-addr" description:"If set, the remote PFCP agent address the server is configured with"`
+	N3InterfaceAddress string        `short:"n" long:"n3-addr" description:"The IPv4 address of the UPF's N3 interface the server is configured with. Required with --remote-peer-addr"`
+	Retries            uint32        `long:"retries" description:"The number of times the setup is attempted again, once a failed attempt is rolled back"`
+	RetryDelay         time.Duration `long:"retry-delay" default:"1s" description:"The time to wait before attempting the setup again"`
+}
+type checkDataPath struct {
+	N3Address string        `short:"n" long:"n3-addr" description:"The GTP-U address to echo. If not set, the configured N3 address is used"`
+	Timeout   time.Duration `long:"timeout" default:"1s" description:"The time to wait for the GTP-U Echo Response"`
+}
+type selfTest struct {
+	BaseID     int32  `short:"i" long:"baseID" description:"The ID of the test session. If not set, the first ID not used by any active session"`
+	GnBAddress string `short:"g" long:"gnb-addr" description:"The (e/g)NodeB address of the test session"`
+	UePool     string `short:"u" long:"ue-pool" description:"The UE pool address of the test session"`
+	JUnitOut   string `long:"junit-out" description:"If set, the file the outcome of each step is written to, as a JUnit XML report"`
+}
 type configureRemoteAddresses struct {
-	RemotePeerAddress  string `short:"r" long:"remote-peer-addr" default:"" description:"The remote PFCP agent address."`
-	N3InterfaceAddress string `short:"n" long:"n3-addr" default:"" description:"The IPv4 address of the UPF's N3 interface"`
+	RemotePeerAddress      string        `short:"r" long:"remote-peer-addr" default:"" description:"The remote PFCP agent address."`
+	N3InterfaceAddress     string        `short:"n" long:"n3-addr" default:"" description:"The IPv4 address of the UPF's N3 interface"`
+	Strictness             string        `long:"strictness" default:"normal" choice:"lenient" choice:"normal" choice:"strict" description:"How aggressively requests are validated and non-fatal UPF responses are handled"`
+	AssociationCooldown    time.Duration `long:"association-cooldown" default:"0s" description:"Minimum time between a disassociation and a new association attempt (e.g. 2s)"`
+	ThrottleOnOverload     bool          `long:"throttle-on-overload" description:"If set, session creation waits while the UPF signals an overload"`
+	HonorOverloadReduction bool          `long:"honor-overload-reduction" description:"If set, the server maximum session rate is reduced by the reduction metric advertised by the UPF while it signals an overload. Requires the server to run with --max-session-rate"`
+	IncludedIETypes        []uint32      `long:"include-ie" description:"If set, the type of an optional IE included in sent messages, omitting other optional IEs. Can be repeated"`
+	ExcludedIETypes        []uint32      `long:"exclude-ie" description:"The type of an IE omitted from sent messages (e.g. 29 for Precedence). Can be repeated"`
+	NodeType               string        `long:"node-type" choice:"smf" choice:"sgw-c" choice:"combined" description:"If set, the CP function advertised in the association, along with its CP Function Features"`
+	ResponseTimeout        time.Duration `long:"response-timeout" description:"If set, the time to wait for a PFCP response before retransmitting the request, or failing (default is 5s)"`
+	Retransmissions        uint32        `long:"retransmissions" description:"The number of times an unanswered PFCP request is retransmitted"`
+	TimeoutBackoff         float64       `long:"timeout-backoff" description:"If set, multiplies the time to wait for a PFCP response after each retransmission (e.g. 2). Must not be lower than 1"`
+	MaxPDRs                uint32        `long:"max-pdrs" description:"If set, the maximum number of PDRs of a session supported by the UPF"`
+	MaxFARs                uint32        `long:"max-fars" description:"If set, the maximum number of FARs of a session supported by the UPF"`
+	MaxQERs                uint32        `long:"max-qers" description:"If set, the maximum number of QERs of a session supported by the UPF"`
+	RecoveryTimeStamp      int64         `long:"recovery-timestamp" description:"If set, the recovery time stamp advertised by the simulator, in seconds since the Unix epoch"`
+	BumpRecoveryTimeStamp  bool          `long:"bump-recovery-timestamp" description:"Advance the recovery time stamp, so that the UPF observes the simulator restarting"`
+	DefaultDNN             string        `long:"default-dnn" description:"If set, the DNN (Network Instance) of the sessions created without --dnn"`
+	LogSeqWraparound       bool          `long:"log-seq-wraparound" description:"If set, log whenever the 24-bit PFCP sequence number wraps around"`
+	MinTEID                uint32        `long:"teid-min" description:"If set, the lowest uplink TEID allocated to the sessions. Requires --teid-max"`
+	MaxTEID                uint32        `long:"teid-max" description:"If set, the highest uplink TEID allocated to the sessions. Requires --teid-min"`
 }
 
 type serviceOptions struct {
 	Associate    associate                `command:"associate"`
 	Disassociate disassociate             `command:"disassociate"`
+	Reassociate  reassociate              `command:"reassociate"`
 	Configure    configureRemoteAddresses `command:"configure"`
+	UPFLoad      upfLoad                  `command:"upf-load"`
+	SelfTest     selfTest                 `command:"selftest"`
+	DataPath     checkDataPath            `command:"check-data-path"`
+	Drain        drain                    `command:"drain"`
+	Setup        setup                    `command:"setup"`
 }
 
 func RegisterServiceCommands(parser *flags.Parser) {
@@ -33,8 +82,28 @@ func (c *configureRemoteAddresses) Execute(args []string) error {
 	defer disconnect()
 
 	res, err := client.Configure(context.Background(), &pb.ConfigureRequest{
-		UpfN3Address:      c.N3InterfaceAddress,
-		RemotePeerAddress: c.RemotePeerAddress,
+		UpfN3Address:             c.N3InterfaceAddress,
+		RemotePeerAddress:        c.RemotePeerAddress,
+		Strictness:               pb.Strictness(pb.Strictness_value[strings.ToUpper(c.Strictness)]),
+		AssociationCooldownMs:    uint32(c.AssociationCooldown.Milliseconds()),
+		ThrottleOnOverload:       c.ThrottleOnOverload,
+		HonorOverloadReduction:   c.HonorOverloadReduction,
+		IncludedIETypes:          c.IncludedIETypes,
+		ExcludedIETypes:          c.ExcludedIETypes,
+		NodeType:                 pb.NodeType(pb.NodeType_value[strings.ToUpper(strings.ReplaceAll(c.NodeType, "-", "_"))]),
+		ResponseTimeoutMs:        uint32(c.ResponseTimeout.Milliseconds()),
+		Retransmissions:          c.Retransmissions,
+		TimeoutBackoffMultiplier: c.TimeoutBackoff,
+		RuleLimits: &pb.RuleLimits{
+			MaxPDRs: c.MaxPDRs,
+			MaxFARs: c.MaxFARs,
+			MaxQERs: c.MaxQERs,
+		},
+		RecoveryTimeStamp:     c.RecoveryTimeStamp,
+		BumpRecoveryTimeStamp: c.BumpRecoveryTimeStamp,
+		DefaultDNN:            c.DefaultDNN,
+		LogSequenceWraparound: c.LogSeqWraparound,
+		TeidRange:             toTEIDRange(c.MinTEID, c.MaxTEID),
 	})
 
 	if err != nil {
@@ -73,3 +142,151 @@ func (c *disassociate) Execute(args []string) error {
 
 	return nil
 }
+
+func (c *reassociate) Execute(args []string) error {
+	client := connect()
+	defer disconnect()
+
+	res, err := client.ReAssociate(context.Background(), &pb.EmptyRequest{})
+	if err != nil {
+		log.Fatalf("Error while re-associating: %v", err)
+	}
+
+	log.Infof(res.Message)
+
+	return nil
+}
+
+func (c *drain) Execute(args []string) error {
+	client := connect()
+	defer disconnect()
+
+	res, err := client.SetDrain(context.Background(), &pb.SetDrainRequest{Drain: !c.Stop})
+	if err != nil {
+		log.Fatalf("Error while setting drain: %v", err)
+	}
+
+	log.Info(res.Message)
+
+	return nil
+}
+
+func (c *setup) Execute(args []string) error {
+	client := connect()
+	defer disconnect()
+
+	c.validate()
+
+	request := &pb.SetupRequest{
+		Sessions: &pb.CreateSessionRequest{
+			Count:         int32(c.Count),
+			BaseID:        int32(c.BaseID),
+			NodeBAddress:  c.GnBAddress,
+			UeAddressPool: c.UePool,
+			AppFilters:    c.appFilters(),
+			Qfi:           int32(c.QFI),
+		},
+		Retries:      c.Retries,
+		RetryDelayMs: uint32(c.RetryDelay.Milliseconds()),
+	}
+
+	if c.RemotePeerAddress != "" {
+		request.Configure = &pb.ConfigureRequest{
+			UpfN3Address:      c.N3InterfaceAddress,
+			RemotePeerAddress: c.RemotePeerAddress,
+		}
+	}
+
+	res, err := client.Setup(context.Background(), request)
+	if err != nil {
+		log.Fatalf("Error while setting up: %v", err)
+	}
+
+	log.WithField("transactionID", res.TransactionID).Info(res.Message)
+
+	return nil
+}
+
+func (c *upfLoad) Execute(args []string) error {
+	client := connect()
+	defer disconnect()
+
+	res, err := client.GetUPFLoad(context.Background(), &pb.EmptyRequest{})
+	if err != nil {
+		log.Fatalf("Error while retrieving the UPF load: %v", err)
+	}
+
+	if res.Load == nil {
+		log.Info("The UPF did not advertise its load")
+	} else {
+		log.Infof("UPF load: %v%% (sequence number: %v)", res.Load.Metric, res.Load.SequenceNumber)
+	}
+
+	if res.Overload == nil {
+		log.Info("The UPF did not advertise any overload")
+	} else {
+		log.Infof("UPF overload: active: %v, reduction metric: %v%%, remaining validity: %v (sequence number: %v)",
+			res.Overload.Active, res.Overload.ReductionMetric,
+			time.Duration(res.Overload.RemainingValidityMs)*time.Millisecond, res.Overload.SequenceNumber)
+	}
+
+	return nil
+}
+
+func (c *selfTest) Execute(args []string) error {
+	client := connect()
+	defer disconnect()
+
+	start := time.Now()
+
+	res, err := client.SelfTest(context.Background(), &pb.SelfTestRequest{
+		BaseID:        c.BaseID,
+		NodeBAddress:  c.GnBAddress,
+		UeAddressPool: c.UePool,
+	})
+	if err != nil {
+		writeJUnitReport(c.JUnitOut, newJUnitTestCase("selftest", "selftest", time.Since(start), err))
+		log.Fatalf("Error while running the self-test: %v", err)
+	}
+
+	writeJUnitReport(c.JUnitOut, selfTestToJUnit(res)...)
+
+	for _, step := range res.Steps {
+		duration := time.Duration(step.DurationMs) * time.Millisecond
+
+		if step.Passed {
+			log.Infof("Step %v passed in %v", step.Name, duration)
+		} else {
+			log.Errorf("Step %v failed in %v: %v", step.Name, duration, step.Error)
+		}
+	}
+
+	if !res.Passed {
+		log.Fatalf("Self-test failed")
+	}
+
+	log.Info("Self-test passed")
+
+	return nil
+}
+
+func (c *checkDataPath) Execute(args []string) error {
+	client := connect()
+	defer disconnect()
+
+	res, err := client.CheckDataPath(context.Background(), &pb.CheckDataPathRequest{
+		N3Address: c.N3Address,
+		TimeoutMs: uint32(c.Timeout.Milliseconds()),
+	})
+	if err != nil {
+		log.Fatalf("Error while checking the data path: %v", err)
+	}
+
+	if !res.Reachable {
+		log.Fatalf("Data path is down: %v", res.Error)
+	}
+
+	log.Infof("Data path is up. Round-trip time: %v", time.Duration(res.RoundTripTimeUs)*time.Microsecond)
+
+	return nil
+}
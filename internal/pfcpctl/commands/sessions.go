@@ -5,6 +5,9 @@ package commands
 
 import (
 	"context"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 	pb "github.com/infinitydon/pfcpsim/api"
@@ -16,8 +19,10 @@ type commonArgs struct {
 	BaseID          int      `short:"i" long:"baseID"  default:"1" description:"The base ID to use"`
 	UePool          string   `short:"u" long:"ue-pool" default:"17.0.0.0/24" description:"The UE pool address"`
 	GnBAddress      string   `short:"g" long:"gnb-addr" description:"The UE pool address"`
-	AppFilterString []string `short:"a" long:"app-filter" default:"ip:any:any:allow:100" description:"Specify an application filter. Format: '{ip | udp | tcp}:{IPv4 Prefix | any}:{<lower-L4-port>-<upper-L4-port> | any}:{allow | deny}:{rule-precedence}' . e.g. 'udp:10.0.0.0/8:80-88:allow:100'"`
+	AppFilterString []string `short:"a" long:"app-filter" default:"ip:any:any:allow:100" description:"Specify an application filter. Format: '{ip | udp | tcp}:{IPv4 Prefix | any}:{<lower-L4-port>-<upper-L4-port> | any}:{allow | deny | drop}:{rule-precedence}' . e.g. 'udp:10.0.0.0/8:80-88:allow:100'. Sub-flows with distinct gate statuses can be separated by commas, e.g. 'udp:any:80-80:allow:100,udp:any:81-81:deny:100'"`
 	QFI             uint8    `short:"q" long:"qfi" description:"The QFI value for QERs. Max value 64."`
+	FlowFile        string   `long:"flow-file" description:"A file defining a flow per line, as '<protocol> <IPv4 address or prefix | any> <port | lower-upper | any> [allow | deny | drop] [precedence]', converted to the application filters. Replaces --app-filter"`
+	JUnitOut        string   `long:"junit-out" description:"If set, the file the outcome of the operation is written to, as a JUnit XML report"`
 }
 
 func (a *commonArgs) validate() {
@@ -30,17 +35,68 @@ func (a *commonArgs) validate() {
 	}
 }
 
+// appFilters returns the application filters generated from the flow file, if provided,
+// or the ones provided through command line.
+func (a *commonArgs) appFilters() []string {
+	if a.FlowFile == "" {
+		return a.AppFilterString
+	}
+
+	filters, err := readFlowFile(a.FlowFile)
+	if err != nil {
+		log.Fatalf("Error while reading flow file %v: %v", a.FlowFile, err)
+	}
+
+	return filters
+}
+
 type sessionCreate struct {
 	Args struct {
 		commonArgs
+		CPFSEIDAddress         string        `long:"cp-fseid-addr" description:"The address to advertise in the CP F-SEID. If not set, the pfcpsim local address is used"`
+		UplinkDefaultAction    string        `long:"uplink-default-action" choice:"forward" choice:"drop" choice:"buffer" description:"If set, adds a fallback uplink PDR applying this action to unmatched traffic"`
+		DownlinkDefaultAction  string        `long:"downlink-default-action" choice:"forward" choice:"drop" choice:"buffer" description:"If set, adds a fallback downlink PDR applying this action to unmatched traffic"`
+		FiveQI                 uint8         `long:"5qi" description:"If set, the standardized 5QI driving QFI, MBR and GBR of the session QER. QFI, if provided, takes precedence"`
+		URRMeasurementMethods  []string      `long:"urr-measurement-method" choice:"volume" choice:"duration" choice:"event" description:"If set, adds a URR to each session using this measurement method. Can be repeated"`
+		UeIPv6PrefixLength     uint8         `long:"ue-ipv6-prefix-len" description:"If set, each session delegates an IPv6 prefix of this length to the UE, allocated from the UE pool, instead of a single address"`
+		SessionQERID           uint32        `long:"session-qer-id" description:"If set, the ID of the session QER referenced by all the PDRs, instead of 0"`
+		PredefinedRules        []string      `long:"predefined-rule" description:"The name of a rule predefined in the UPF, activated by the PDRs of the application filters. Can be repeated"`
+		UplinkMBR              uint64        `long:"uplink-mbr" description:"If set, the uplink MBR of the session QER, expressed in --bitrate-unit"`
+		DownlinkMBR            uint64        `long:"downlink-mbr" description:"If set, the downlink MBR of the session QER, expressed in --bitrate-unit"`
+		UplinkGBR              uint64        `long:"uplink-gbr" description:"If set, the uplink GBR of the session QER, expressed in --bitrate-unit"`
+		DownlinkGBR            uint64        `long:"downlink-gbr" description:"If set, the downlink GBR of the session QER, expressed in --bitrate-unit"`
+		BitRateUnit            string        `long:"bitrate-unit" default:"kbps" choice:"bps" choice:"kbps" choice:"mbps" description:"The unit of the session QER bit rates"`
+		RuleIDOffset           uint32        `long:"rule-id-offset" description:"If set, added to all the rule IDs of the sessions, to avoid collisions with rules created by other CP functions"`
+		HoldTime               time.Duration `long:"hold-time" description:"If set, each session is deleted once this time (e.g. 30s) has elapsed since its creation"`
+		HoldTimeDistribution   string        `long:"hold-time-distribution" default:"fixed" choice:"fixed" choice:"exponential" description:"The distribution of the session hold times, whose mean is --hold-time"`
+		InitialDownlinkAction  string        `long:"initial-downlink-action" choice:"drop" choice:"buffer" description:"If set, the action of the downlink FARs until the sessions are modified with the nodeB address"`
+		MBSMulticastAddress    string        `long:"mbs-multicast-addr" description:"If set, makes the sessions MBS sessions, whose downlink PDRs match the IP multicast traffic sent to this address"`
+		MBSSourceAddress       string        `long:"mbs-source-addr" description:"If set, the only source of the MBS multicast traffic"`
+		TrafficEndpoint        bool          `long:"traffic-endpoint" description:"If set, each session creates a Traffic Endpoint carrying both the F-TEID and the UE IP address, referenced by all its PDRs"`
+		TrafficSteeringUL      string        `long:"traffic-steering-ul" description:"If set, the Traffic Steering Policy Identifier of the uplink FARs of the application filters"`
+		TrafficSteeringDL      string        `long:"traffic-steering-dl" description:"If set, the Traffic Steering Policy Identifier of the downlink FARs of the application filters"`
+		DNN                    string        `long:"dnn" description:"If set, the DNN (Network Instance) of the sessions, overriding the default DNN of the server"`
+		DuplicateUEAddress     string        `long:"duplicate-ue-address" default:"allow" choice:"allow" choice:"reject" choice:"skip" description:"How the sessions whose UE address is used by another session of the batch are handled"`
+		CheckActiveUEAddresses bool          `long:"check-active-ue-addresses" description:"If set, the duplicate UE address check includes the active sessions"`
+		InactivityTimer        time.Duration `long:"inactivity-timer" description:"If set, the User Plane Inactivity Timer of the sessions (e.g. 30s), after which the UPF reports their inactivity. Must be a whole number of seconds"`
+		PostCreateDelay        time.Duration `long:"post-create-delay" description:"If set, the time to wait once the sessions are established before returning (e.g. 500ms), for UPFs provisioning sessions asynchronously"`
+		ReportRuleStatus       bool          `long:"report-rule-status" description:"If set, reports the PDRs of each session confirmed by the UPF through Created PDR IEs, with the resources it allocated"`
+		ReportLatency          bool          `long:"report-latency" description:"If set, reports the percentiles of the session establishment latency"`
+		WarmUpCount            uint32        `long:"warm-up-count" description:"If set, the number of first sessions excluded from the reported latency, e.g. while the UPF caches are cold"`
+		WarmUpDuration         time.Duration `long:"warm-up-duration" description:"If set, the sessions established during this first period (e.g. 5s) are excluded from the reported latency"`
+		RuleIDs                []string      `long:"rule-ids" description:"The explicit IDs of the rules of an application flow, as '<uplink-pdr>:<downlink-pdr>:<uplink-far>:<downlink-far>[:<uplink-qer>:<downlink-qer>]'. If set, must be repeated for each flow, in order"`
 	}
 }
 
 type sessionModify struct {
 	Args struct {
 		commonArgs
-		BufferFlag   bool `short:"b" long:"buffer" description:"If set, downlink FARs will have the buffer flag set to true"`
-		NotifyCPFlag bool `short:"n" long:"notifycp" description:"If set, downlink FARs will have the notify CP flag set to true"`
+		BufferFlag         bool     `short:"b" long:"buffer" description:"If set, downlink FARs will have the buffer flag set to true"`
+		NotifyCPFlag       bool     `short:"n" long:"notifycp" description:"If set, downlink FARs will have the notify CP flag set to true"`
+		URRVolumeThreshold uint64   `long:"urr-volume-threshold" description:"If set, updates the URRs of the sessions with this volume threshold, in bytes"`
+		RemoveURRs         bool     `long:"remove-urrs" description:"If set, removes the URRs of the sessions"`
+		IgnoreMissing      bool     `long:"ignore-missing" description:"If set, skips the sessions which are not active instead of failing"`
+		Sequence           []string `long:"sequence" description:"A step of a modification sequence, as '{forward | buffer}[:<delay>]' (e.g. 'forward:500ms'). The steps are applied in order to the downlink FARs. Can be repeated"`
 	}
 }
 
@@ -50,10 +106,24 @@ type sessionDelete struct {
 	}
 }
 
+type sessionGet struct {
+	Args struct {
+		ID int32 `short:"i" long:"id" required:"true" description:"The ID of the session, derived from the baseID it was created with"`
+	}
+}
+
+type sessionMapping struct {
+	Args struct {
+		Format string `long:"format" default:"table" choice:"table" choice:"csv" description:"The format the mapping is written to the standard output in"`
+	}
+}
+
 type SessionOptions struct {
-	Create sessionCreate `command:"create"`
-	Modify sessionModify `command:"modify"`
-	Delete sessionDelete `command:"delete"`
+	Create  sessionCreate  `command:"create"`
+	Modify  sessionModify  `command:"modify"`
+	Delete  sessionDelete  `command:"delete"`
+	Get     sessionGet     `command:"get"`
+	Mapping sessionMapping `command:"mapping"`
 }
 
 func RegisterSessionCommands(parser *flags.Parser) {
@@ -65,25 +135,86 @@ func (s *sessionCreate) Execute(args []string) error {
 		log.Fatalf("QFI cannot be greater than 64. Provided QFI: %v", s.Args.QFI)
 	}
 
+	if s.Args.InactivityTimer%time.Second != 0 {
+		log.Fatalf("Inactivity timer must be a whole number of seconds. Provided inactivity timer: %v", s.Args.InactivityTimer)
+	}
+
 	client := connect()
 	defer disconnect()
 
 	s.Args.validate()
 
+	ruleIDs, err := toRuleIDs(s.Args.RuleIDs)
+	if err != nil {
+		log.Fatalf("Error while parsing rule IDs: %v", err)
+	}
+
+	start := time.Now()
+
 	res, err := client.CreateSession(context.Background(), &pb.CreateSessionRequest{
-		Count:         int32(s.Args.Count),
-		BaseID:        int32(s.Args.BaseID),
-		NodeBAddress:  s.Args.GnBAddress,
-		UeAddressPool: s.Args.UePool,
-		AppFilters:    s.Args.AppFilterString,
-		Qfi:           int32(s.Args.QFI),
+		Count:                    int32(s.Args.Count),
+		BaseID:                   int32(s.Args.BaseID),
+		NodeBAddress:             s.Args.GnBAddress,
+		UeAddressPool:            s.Args.UePool,
+		AppFilters:               s.Args.appFilters(),
+		Qfi:                      int32(s.Args.QFI),
+		CpFSEIDAddress:           s.Args.CPFSEIDAddress,
+		UplinkDefaultAction:      toFARAction(s.Args.UplinkDefaultAction),
+		DownlinkDefaultAction:    toFARAction(s.Args.DownlinkDefaultAction),
+		Urr:                      toURRSpec(s.Args.URRMeasurementMethods),
+		FiveQI:                   int32(s.Args.FiveQI),
+		UeIPv6PrefixLength:       int32(s.Args.UeIPv6PrefixLength),
+		SessionQerID:             s.Args.SessionQERID,
+		PredefinedRules:          s.Args.PredefinedRules,
+		UplinkMBR:                s.Args.UplinkMBR,
+		DownlinkMBR:              s.Args.DownlinkMBR,
+		UplinkGBR:                s.Args.UplinkGBR,
+		DownlinkGBR:              s.Args.DownlinkGBR,
+		BitRateUnit:              pb.BitRateUnit(pb.BitRateUnit_value[strings.ToUpper(s.Args.BitRateUnit)]),
+		RuleIDOffset:             s.Args.RuleIDOffset,
+		HoldTime:                 toHoldTime(s.Args.HoldTime, s.Args.HoldTimeDistribution),
+		InitialDownlinkAction:    toFARAction(s.Args.InitialDownlinkAction),
+		Mbs:                      toMBSSpec(s.Args.MBSMulticastAddress, s.Args.MBSSourceAddress),
+		TrafficEndpoint:          s.Args.TrafficEndpoint,
+		TrafficSteering:          toTrafficSteering(s.Args.TrafficSteeringUL, s.Args.TrafficSteeringDL),
+		Dnn:                      s.Args.DNN,
+		DuplicateUEAddressPolicy: pb.DuplicateUEAddressPolicy(pb.DuplicateUEAddressPolicy_value[strings.ToUpper(s.Args.DuplicateUEAddress)+"_DUPLICATES"]),
+		CheckActiveUEAddresses:   s.Args.CheckActiveUEAddresses,
+		InactivityTimerMs:        uint32(s.Args.InactivityTimer.Milliseconds()),
+		RuleIDs:                  ruleIDs,
+		PostCreateDelayMs:        uint32(s.Args.PostCreateDelay.Milliseconds()),
+		ReportRuleStatus:         s.Args.ReportRuleStatus,
+		ReportLatency:            s.Args.ReportLatency,
+		WarmUpCount:              s.Args.WarmUpCount,
+		WarmUpMs:                 uint32(s.Args.WarmUpDuration.Milliseconds()),
 	})
 
+	writeJUnitReport(s.Args.JUnitOut, sessionBatchToJUnit("create", s.Args.BaseID, s.Args.Count, time.Since(start), err)...)
+
 	if err != nil {
 		log.Fatalf("Error while creating sessions: %v", err)
 	}
 
-	log.Infof(res.Message)
+	log.WithField("transactionID", res.TransactionID).Infof(res.Message)
+
+	for _, sessionStatus := range res.RuleStatus {
+		for _, rule := range sessionStatus.Rules {
+			if !rule.Confirmed {
+				log.Warnf("Session %v, PDR %v: not confirmed by the UPF", sessionStatus.SessionID, rule.PdrID)
+				continue
+			}
+
+			log.Infof("Session %v, PDR %v: confirmed by the UPF. TEID: %v, N3 address: %v, UE address: %v",
+				sessionStatus.SessionID, rule.PdrID, rule.Teid, rule.N3Address, rule.UeAddress)
+		}
+	}
+
+	if latency := res.Latency; latency != nil {
+		log.Infof("Session establishment latency of %v sessions (%v warm-up sessions excluded): p50 %v, p90 %v, p99 %v, max %v",
+			latency.Samples, latency.WarmUpSessions, time.Duration(latency.P50Us)*time.Microsecond,
+			time.Duration(latency.P90Us)*time.Microsecond, time.Duration(latency.P99Us)*time.Microsecond,
+			time.Duration(latency.MaxUs)*time.Microsecond)
+	}
 
 	return nil
 }
@@ -94,16 +225,29 @@ func (s *sessionModify) Execute(args []string) error {
 
 	s.Args.validate()
 
+	sequence, err := toModificationSequence(s.Args.Sequence)
+	if err != nil {
+		log.Fatalf("Error while parsing modification sequence: %v", err)
+	}
+
+	start := time.Now()
+
 	res, err := client.ModifySession(context.Background(), &pb.ModifySessionRequest{
-		Count:         int32(s.Args.Count),
-		BaseID:        int32(s.Args.BaseID),
-		NodeBAddress:  s.Args.GnBAddress,
-		UeAddressPool: s.Args.UePool,
-		BufferFlag:    s.Args.BufferFlag,
-		NotifyCPFlag:  s.Args.NotifyCPFlag,
-		AppFilters:    s.Args.AppFilterString,
+		Count:              int32(s.Args.Count),
+		BaseID:             int32(s.Args.BaseID),
+		NodeBAddress:       s.Args.GnBAddress,
+		UeAddressPool:      s.Args.UePool,
+		BufferFlag:         s.Args.BufferFlag,
+		NotifyCPFlag:       s.Args.NotifyCPFlag,
+		AppFilters:         s.Args.appFilters(),
+		UrrVolumeThreshold: s.Args.URRVolumeThreshold,
+		RemoveURRs:         s.Args.RemoveURRs,
+		IgnoreMissing:      s.Args.IgnoreMissing,
+		Sequence:           sequence,
 	})
 
+	writeJUnitReport(s.Args.JUnitOut, sessionBatchToJUnit("modify", s.Args.BaseID, s.Args.Count, time.Since(start), err)...)
+
 	if err != nil {
 		log.Fatalf("Error while modifying sessions: %v", err)
 	}
@@ -119,10 +263,15 @@ func (s *sessionDelete) Execute(args []string) error {
 
 	s.Args.validate()
 
+	start := time.Now()
+
 	res, err := client.DeleteSession(context.Background(), &pb.DeleteSessionRequest{
 		Count:  int32(s.Args.Count),
 		BaseID: int32(s.Args.BaseID),
 	})
+
+	writeJUnitReport(s.Args.JUnitOut, sessionBatchToJUnit("delete", s.Args.BaseID, s.Args.Count, time.Since(start), err)...)
+
 	if err != nil {
 		log.Fatalf("Error while deleting sessions: %v", err)
 	}
@@ -131,3 +280,50 @@ func (s *sessionDelete) Execute(args []string) error {
 
 	return nil
 }
+
+func (s *sessionGet) Execute(args []string) error {
+	client := connect()
+	defer disconnect()
+
+	res, err := client.GetSession(context.Background(), &pb.GetSessionRequest{
+		SessionID: s.Args.ID,
+	})
+	if err != nil {
+		log.Fatalf("Error while retrieving session: %v", err)
+	}
+
+	log.Infof("Session %v: local SEID: %v, peer SEID: %v, UE address: %v, uplink TEID: %v, URR IDs: %v",
+		res.SessionID, res.LocalSEID, res.PeerSEID, res.UeAddress, res.UplinkTEID, res.UrrIDs)
+
+	for _, pdr := range res.Pdrs {
+		log.Infof("PDR %v: precedence: %v, source interface: %v, SDF filter: '%v', FAR ID: %v, QER IDs: %v, URR IDs: %v",
+			pdr.Id, pdr.Precedence, pdr.SourceInterface, pdr.SdfFilter, pdr.FarID, pdr.QerIDs, pdr.UrrIDs)
+	}
+
+	for _, far := range res.Fars {
+		log.Infof("FAR %v: apply action: %#x, outer header address: %v, outer header TEID: %v",
+			far.Id, far.ApplyAction, far.OuterHeaderAddress, far.OuterHeaderTEID)
+	}
+
+	for _, qer := range res.Qers {
+		log.Infof("QER %v: QFI: %v, uplink MBR: %v kbps, downlink MBR: %v kbps", qer.Id, qer.Qfi, qer.UplinkMBR, qer.DownlinkMBR)
+	}
+
+	return nil
+}
+
+func (s *sessionMapping) Execute(args []string) error {
+	client := connect()
+	defer disconnect()
+
+	res, err := client.GetSessionMapping(context.Background(), &pb.EmptyRequest{})
+	if err != nil {
+		log.Fatalf("Error while retrieving the session mapping: %v", err)
+	}
+
+	if err := writeSessionMapping(os.Stdout, res.Sessions, s.Args.Format); err != nil {
+		log.Fatalf("Error while writing the session mapping: %v", err)
+	}
+
+	return nil
+}
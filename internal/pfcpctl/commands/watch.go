@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	pb "github.com/infinitydon/pfcpsim/api"
+	"github.com/jessevdk/go-flags"
+	log "github.com/sirupsen/logrus"
+)
+
+// clearScreen moves the cursor to the top-left corner and clears the terminal.
+const clearScreen = "\033[H\033[2J"
+
+type watch struct {
+	Interval time.Duration `long:"interval" default:"2s" description:"The interval between two refreshes (e.g. 500ms, 5s)"`
+}
+
+func RegisterWatchCommand(parser *flags.Parser) {
+	_, _ = parser.AddCommand("watch", "Watch sessions", "Command to continuously display the number of active sessions", &watch{})
+}
+
+func (w *watch) Execute(args []string) error {
+	if w.Interval <= 0 {
+		log.Fatalf("Interval must be a positive duration. Provided interval: %v", w.Interval)
+	}
+
+	client := connect()
+	defer disconnect()
+
+	// stop watching on Ctrl-C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	watchSessions(ctx, client, w.Interval, os.Stdout)
+
+	return nil
+}
+
+// watchSessions polls client every interval and renders the session count to out, until ctx is done.
+// The same client connection is reused across polls.
+func watchSessions(ctx context.Context, client pb.PFCPSimClient, interval time.Duration, out io.Writer) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		renderSessionCount(ctx, client, interval, out)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func renderSessionCount(ctx context.Context, client pb.PFCPSimClient, interval time.Duration, out io.Writer) {
+	res, err := client.GetSessionCount(ctx, &pb.EmptyRequest{})
+	if err != nil {
+		if ctx.Err() == nil {
+			fmt.Fprintf(out, "%sError while retrieving session count: %v\n", clearScreen, err)
+		}
+
+		return
+	}
+
+	fmt.Fprintf(out, "%sEvery %v: %v\n\n", clearScreen, interval, time.Now().Format(time.RFC1123))
+	fmt.Fprintf(out, "Associated:      %v\n", res.Associated)
+	fmt.Fprintf(out, "Active sessions: %v\n", res.Count)
+}
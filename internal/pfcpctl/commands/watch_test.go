@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/infinitydon/pfcpsim/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// stubClient implements pb.PFCPSimClient. Only GetSessionCount is stubbed.
+type stubClient struct {
+	pb.PFCPSimClient
+
+	lock  sync.Mutex
+	polls []time.Time
+}
+
+func (c *stubClient) GetSessionCount(ctx context.Context, in *pb.EmptyRequest, opts ...grpc.CallOption) (*pb.SessionCountResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.polls = append(c.polls, time.Now())
+
+	return &pb.SessionCountResponse{Count: int32(len(c.polls)), Associated: true}, nil
+}
+
+func TestWatchSessions(t *testing.T) {
+	client := &stubClient{}
+	out := &bytes.Buffer{}
+	interval := 50 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*interval+interval/2)
+	defer cancel()
+
+	watchSessions(ctx, client, interval, out)
+
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	// one poll at start, then one per interval
+	require.GreaterOrEqual(t, len(client.polls), 5)
+	require.LessOrEqual(t, len(client.polls), 7)
+
+	for i := 1; i < len(client.polls); i++ {
+		require.InDelta(t, interval, client.polls[i].Sub(client.polls[i-1]), float64(interval/2))
+	}
+
+	require.Contains(t, out.String(), "Associated:      true")
+	require.Contains(t, out.String(), "Active sessions: 5")
+	require.Equal(t, len(client.polls), strings.Count(out.String(), clearScreen))
+}
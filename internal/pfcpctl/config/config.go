@@ -15,11 +15,16 @@ const (
 )
 
 var GlobalOptions struct {
-	Server string `short:"s" long:"server" default:"" value-name:"SERVER:PORT" description:"IP/Host and port of pfcpsim gRPC Server"`
+	Server         string `short:"s" long:"server" default:"" value-name:"SERVER:PORT" description:"IP/Host and port of pfcpsim gRPC Server"`
+	MaxRecvMsgSize int    `long:"max-recv-msg-size" value-name:"BYTES" description:"If set, the maximum size of a gRPC message received from the server, instead of 4MB"`
+	MaxSendMsgSize int    `long:"max-send-msg-size" value-name:"BYTES" description:"If set, the maximum size of a gRPC message sent to the server"`
 }
 
 type GlobalConfigSpec struct {
 	Server string
+	// MaxRecvMsgSize and MaxSendMsgSize are the maximum sizes of the gRPC messages. Zero means the gRPC default
+	MaxRecvMsgSize int
+	MaxSendMsgSize int
 }
 
 var GlobalConfig = GlobalConfigSpec{
@@ -38,6 +43,9 @@ func ProcessGlobalOptions() {
 		GlobalConfig.Server = GlobalOptions.Server
 	}
 
+	GlobalConfig.MaxRecvMsgSize = GlobalOptions.MaxRecvMsgSize
+	GlobalConfig.MaxSendMsgSize = GlobalOptions.MaxSendMsgSize
+
 	// Generate error messages for required settings
 	if GlobalConfig.Server == "" {
 		log.Fatal("Server is not set. Please use the -s option")
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// auditRedactedValue replaces the value of the redacted request fields in the audit log.
+const auditRedactedValue = "REDACTED"
+
+// AuditEntry is the record of a RPC call in the audit log.
+type AuditEntry struct {
+	Time   time.Time `json:"time"`
+	Method string    `json:"method"`
+	// Caller is the common name of the client certificate, if verified, followed by the client address
+	Caller   string                 `json:"caller"`
+	Request  map[string]interface{} `json:"request,omitempty"`
+	Code     string                 `json:"code"`
+	Message  string                 `json:"message,omitempty"`
+	Duration string                 `json:"duration"`
+}
+
+// NewAuditInterceptor returns a gRPC interceptor writing an audit entry of every RPC call to sink, one JSON object per line.
+// The values of the request fields in redactedFields (e.g. ueAddressPool) are replaced by REDACTED.
+// Failing to write an entry is logged, but does not make the RPC fail.
+func NewAuditInterceptor(sink io.Writer, redactedFields []string) grpc.UnaryServerInterceptor {
+	var lock sync.Mutex
+
+	redacted := make(map[string]bool, len(redactedFields))
+	for _, field := range redactedFields {
+		redacted[strings.ToLower(field)] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		st := status.Convert(err)
+
+		entry := AuditEntry{
+			Time:     start,
+			Method:   info.FullMethod,
+			Caller:   getCaller(ctx),
+			Request:  redactRequest(req, redacted),
+			Code:     st.Code().String(),
+			Message:  st.Message(),
+			Duration: time.Since(start).String(),
+		}
+
+		b, marshalErr := json.Marshal(entry)
+		if marshalErr != nil {
+			log.Errorf("Error while encoding audit entry of %v: %v", info.FullMethod, marshalErr)
+			return resp, err
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		if _, writeErr := sink.Write(append(b, '\n')); writeErr != nil {
+			log.Errorf("Error while writing audit entry of %v: %v", info.FullMethod, writeErr)
+		}
+
+		return resp, err
+	}
+}
+
+// getCaller returns the identity of the client of a RPC: the common name of its certificate, when mutual TLS
+// is enabled, and its address.
+func getCaller(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 &&
+		len(tlsInfo.State.VerifiedChains[0]) > 0 {
+		return fmt.Sprintf("%v (%v)", tlsInfo.State.VerifiedChains[0][0].Subject.CommonName, p.Addr)
+	}
+
+	return p.Addr.String()
+}
+
+// redactRequest returns the fields of req as encoded in JSON, with the value of the redacted ones replaced.
+func redactRequest(req interface{}, redacted map[string]bool) map[string]interface{} {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil
+	}
+
+	for name := range fields {
+		if redacted[strings.ToLower(name)] {
+			fields[name] = auditRedactedValue
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+
+	pb "github.com/infinitydon/pfcpsim/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestAuditInterceptor(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	var sink bytes.Buffer
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(NewAuditInterceptor(&sink, []string{"ueAddressPool"})))
+	pb.RegisterPFCPSimServer(grpcServer, service)
+
+	go func() { _ = grpcServer.Serve(lis) }()
+
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() })
+
+	client := pb.NewPFCPSimClient(conn)
+
+	_, err = client.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetSession(context.Background(), &pb.GetSessionRequest{SessionID: 100})
+	require.Error(t, err)
+
+	lines := strings.Split(strings.TrimSpace(sink.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var entry AuditEntry
+
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	require.Equal(t, "/api.PFCPSim/CreateSession", entry.Method)
+	require.Equal(t, "OK", entry.Code)
+	require.Contains(t, entry.Caller, "127.0.0.1")
+	require.Equal(t, "10.0.0.1", entry.Request["nodeBAddress"])
+	require.Equal(t, auditRedactedValue, entry.Request["ueAddressPool"])
+
+	entry = AuditEntry{}
+
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &entry))
+	require.Equal(t, "/api.PFCPSim/GetSession", entry.Method)
+	require.Equal(t, "NotFound", entry.Code)
+	require.NotEmpty(t, entry.Message)
+}
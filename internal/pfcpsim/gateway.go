@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	pb "github.com/infinitydon/pfcpsim/api"
+	"google.golang.org/grpc"
+)
+
+// NewRESTHandler returns a HTTP handler exposing the API as REST/JSON, as annotated in api/pfcpsim.proto.
+// Requests are proxied to the gRPC server listening on grpcAddress. The x-pfcp-timeout header is forwarded as metadata.
+func NewRESTHandler(ctx context.Context, grpcAddress string) (http.Handler, error) {
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+		if strings.EqualFold(key, operationBudgetMetadataKey) {
+			return operationBudgetMetadataKey, true
+		}
+
+		return runtime.DefaultHeaderMatcher(key)
+	}))
+
+	err := pb.RegisterPFCPSimHandlerFromEndpoint(ctx, mux, grpcAddress, []grpc.DialOption{grpc.WithInsecure()})
+	if err != nil {
+		return nil, err
+	}
+
+	return mux, nil
+}
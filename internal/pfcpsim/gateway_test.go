@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/infinitydon/pfcpsim/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestRESTHandlerConfigure(t *testing.T) {
+	resetState()
+	t.Cleanup(resetState)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterPFCPSimServer(grpcServer, NewPFCPSimService(""))
+
+	go func() { _ = grpcServer.Serve(lis) }()
+
+	t.Cleanup(grpcServer.Stop)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	handler, err := NewRESTHandler(ctx, lis.Addr().String())
+	require.NoError(t, err)
+
+	restServer := httptest.NewServer(handler)
+	t.Cleanup(restServer.Close)
+
+	resp, err := http.Post(restServer.URL+"/v1/configure", "application/json",
+		strings.NewReader(`{"upfN3Address": "10.0.0.1", "remotePeerAddress": "10.0.0.2", "strictness": "STRICT"}`))
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var res pb.Response
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	require.Contains(t, res.Message, "Server is configured")
+
+	// the request reached the gRPC service
+	require.Equal(t, "10.0.0.1", upfN3Address)
+	require.Equal(t, "10.0.0.2", remotePeerAddress)
+	require.Equal(t, pb.Strictness_STRICT, validationStrictness)
+
+	resp, err = http.Post(restServer.URL+"/v1/configure", "application/json",
+		strings.NewReader(`{"upfN3Address": "not-an-address"}`))
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	require.NotEqual(t, http.StatusOK, resp.StatusCode)
+}
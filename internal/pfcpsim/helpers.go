@@ -4,29 +4,84 @@
 package pfcpsim
 
 import (
+	"context"
+	cryptorand "crypto/rand"
 	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/c-robinson/iplib"
+	pb "github.com/infinitydon/pfcpsim/api"
 	"github.com/infinitydon/pfcpsim/pkg/pfcpsim"
+	"github.com/infinitydon/pfcpsim/pkg/pfcpsim/session"
 	log "github.com/sirupsen/logrus"
 	"github.com/wmnsk/go-pfcp/ie"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 const sdfFilterFormatWPort = "permit out %v from %v to assigned %v-%v"
 const sdfFilterFormatWOPort = "permit out %v from %v to assigned"
 
+// defaultRulesPrecedence is the precedence of the fallback PDRs: the lowest priority.
+const defaultRulesPrecedence = math.MaxUint32
+
+// sharedTrafficEndpointID is the ID of the Traffic Endpoint shared by the PDRs of a session, in traffic endpoint mode.
+const sharedTrafficEndpointID uint8 = 1
+
+// operationBudgetMetadataKey is the gRPC metadata key carrying the maximum duration of the PFCP operations of a RPC (e.g. "3s").
+const operationBudgetMetadataKey = "x-pfcp-timeout"
+
 func connectPFCPSim() error {
+	if assumeAssociated {
+		if sim == nil {
+			sim = pfcpsim.NewPFCPClient(pfcpsim.LoopbackAddress)
+		}
+
+		sim.SetIEFilter(ieFilter)
+		sim.SetNodeType(nodeType)
+		sim.SetPFCPResponseTimeout(responseTimeout)
+		sim.SetRetransmissionPolicy(retransmissions, timeoutBackoff)
+		sim.SetSequenceWraparoundHandler(onSequenceWraparound)
+
+		if !recoveryTimeStamp.IsZero() {
+			sim.SetRecoveryTimeStamp(recoveryTimeStamp)
+		}
+
+		sim.ConnectLoopback(pfcpsim.AcceptAllResponder)
+
+		remotePeerConnected = true
+
+		return nil
+	}
+
 	if sim == nil {
-		localAddr, err := getLocalAddress(interfaceName)
+		localAddr, source, err := selectLocalAddress(localAddress, interfaceName, remotePeerAddress)
 		if err != nil {
 			return err
 		}
 
+		log.Infof("Using local address %v (%v)", localAddr, source)
+
 		sim = pfcpsim.NewPFCPClient(localAddr.String())
+		sim.SetSocketOptions(socketOptions)
+	}
+
+	sim.SetIEFilter(ieFilter)
+	sim.SetNodeType(nodeType)
+	sim.SetPFCPResponseTimeout(responseTimeout)
+	sim.SetRetransmissionPolicy(retransmissions, timeoutBackoff)
+	sim.SetSequenceWraparoundHandler(onSequenceWraparound)
+
+	if !recoveryTimeStamp.IsZero() {
+		sim.SetRecoveryTimeStamp(recoveryTimeStamp)
 	}
 
 	err := sim.ConnectN4(remotePeerAddress)
@@ -34,12 +89,296 @@ func connectPFCPSim() error {
 		return err
 	}
 
+	log.Infof("Connected to remote peer %v using source address %v", remotePeerAddress, sim.SourceAddress())
+
 	remotePeerConnected = true
 
 	return nil
 }
 
+// MessageSizeServerOptions returns the gRPC server options setting the maximum sizes of the received and sent messages,
+// e.g. to allow large batch requests. Zero keeps the gRPC default (4MB for received messages).
+func MessageSizeServerOptions(maxRecvMsgSize int, maxSendMsgSize int) []grpc.ServerOption {
+	var opts []grpc.ServerOption
+
+	if maxRecvMsgSize != 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(maxRecvMsgSize))
+	}
+
+	if maxSendMsgSize != 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(maxSendMsgSize))
+	}
+
+	return opts
+}
+
+// SetSocketOptions sets the options of the UDP socket connected to the remote peer.
+// They are applied to the connections established afterwards.
+func SetSocketOptions(opts pfcpsim.SocketOptions) {
+	socketOptions = opts
+
+	if sim != nil {
+		sim.SetSocketOptions(opts)
+	}
+}
+
+// toIEFilter returns the filter selecting the IEs included in sent messages.
+// Returns error if any of the IE types is not a valid 16-bit type.
+func toIEFilter(includedTypes []uint32, excludedTypes []uint32) (pfcpsim.IEFilter, error) {
+	filter := pfcpsim.IEFilter{}
+
+	for _, ieType := range includedTypes {
+		if ieType > math.MaxUint16 {
+			return filter, pfcpsim.NewInvalidFormatError(fmt.Sprintf("IE type %v", ieType))
+		}
+
+		filter.Include = append(filter.Include, uint16(ieType))
+	}
+
+	for _, ieType := range excludedTypes {
+		if ieType > math.MaxUint16 {
+			return filter, pfcpsim.NewInvalidFormatError(fmt.Sprintf("IE type %v", ieType))
+		}
+
+		filter.Exclude = append(filter.Exclude, uint16(ieType))
+	}
+
+	return filter, nil
+}
+
+// toNodeType converts the node type provided through API to a pfcpsim.NodeType.
+func toNodeType(nodeType pb.NodeType) pfcpsim.NodeType {
+	switch nodeType {
+	case pb.NodeType_SMF:
+		return pfcpsim.NodeTypeSMF
+	case pb.NodeType_SGW_C:
+		return pfcpsim.NodeTypeSGWC
+	case pb.NodeType_COMBINED:
+		return pfcpsim.NodeTypeCombined
+	default:
+		return pfcpsim.NodeTypeUnspecified
+	}
+}
+
+// SetMaxSessionCreationRate sets the server-wide maximum number of sessions established per second,
+// protecting the remote peer from any client request. Zero removes the ceiling.
+func SetMaxSessionCreationRate(sessionsPerSecond float64) {
+	lockSessionCreation.Lock()
+	defer lockSessionCreation.Unlock()
+
+	maxSessionCreationRate = sessionsPerSecond
+	nextSessionCreation = time.Time{}
+}
+
+// isHonorOverloadReductionCorrect returns error if the overload reduction is honored without a maximum session
+// creation rate to reduce.
+func isHonorOverloadReductionCorrect(honor bool) error {
+	lockSessionCreation.Lock()
+	defer lockSessionCreation.Unlock()
+
+	if honor && maxSessionCreationRate <= 0 {
+		errMsg := "Honoring the overload reduction requires a server maximum session creation rate"
+		log.Error(errMsg)
+
+		return status.Error(codes.Aborted, errMsg)
+	}
+
+	return nil
+}
+
+// sessionCreationRate returns the maximum number of sessions established per second. If honoring the overload
+// reduction, it is reduced by the reduction metric of the overload signaled by the remote peer, while it applies.
+// lockSessionCreation must be held.
+func sessionCreationRate() float64 {
+	if !honorOverloadReduction || sim == nil {
+		return maxSessionCreationRate
+	}
+
+	overload := sim.OverloadControl()
+	if !overload.IsActive() {
+		return maxSessionCreationRate
+	}
+
+	reduction := float64(overload.ReductionMetric)
+	if reduction > 100 {
+		reduction = 100
+	}
+
+	return maxSessionCreationRate * (100 - reduction) / 100
+}
+
+// waitSessionCreationSlot blocks until a session can be established without exceeding the server-wide
+// session creation rate. Returns error if ctx is done, or would be done, before.
+// The slot is only reserved once free, so that a caller giving up does not delay the next ones.
+func waitSessionCreationSlot(ctx context.Context) error {
+	for {
+		lockSessionCreation.Lock()
+
+		if maxSessionCreationRate <= 0 {
+			lockSessionCreation.Unlock()
+			return nil
+		}
+
+		rate := sessionCreationRate()
+
+		errMsg := fmt.Sprintf("Session creation exceeds the server maximum rate of %v sessions per second", rate)
+
+		now := time.Now()
+		slot := nextSessionCreation
+
+		if rate <= 0 {
+			// the remote peer requested to stop the traffic: no session is established until the overload expires
+			if expiresAt := sim.OverloadControl().ExpiresAt(); expiresAt.After(slot) {
+				slot = expiresAt
+			}
+		}
+
+		if !slot.After(now) {
+			if rate > 0 {
+				nextSessionCreation = now.Add(time.Duration(float64(time.Second) / rate))
+			}
+			lockSessionCreation.Unlock()
+
+			return nil
+		}
+		lockSessionCreation.Unlock()
+
+		if rate <= 0 {
+			log.Warnf("Remote peer requested to stop the traffic. Waiting until %v before creating sessions", slot)
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(slot) {
+			log.Error(errMsg)
+			return status.Error(codes.ResourceExhausted, errMsg)
+		}
+
+		select {
+		case <-time.After(time.Until(slot)):
+		case <-ctx.Done():
+			log.Error(errMsg)
+			return status.Error(codes.ResourceExhausted, errMsg)
+		}
+	}
+}
+
+// SetAssumeAssociatedMode enables or disables the assume-associated mode. In this mode the server
+// does not need to be configured nor associated: session operations build and validate PFCP messages,
+// but messages are sent to an emulated peer accepting every request, instead of the remote peer.
+// Messages sent are recorded and can be inspected through sim.SentMessages().
+func SetAssumeAssociatedMode(enabled bool) {
+	assumeAssociated = enabled
+
+	if enabled && upfN3Address == "" {
+		upfN3Address = pfcpsim.LoopbackAddress
+	}
+}
+
+// SetAutoAssociateMode enables or disables the auto-associate mode. In this mode, session operations finding
+// no association with the configured remote peer connect and associate first, as Associate does, then proceed.
+// The operations still fail if the association does.
+func SetAutoAssociateMode(enabled bool) {
+	autoAssociate = enabled
+}
+
+// associateAutomatically connects to the remote peer and sets up the association, in auto-associate mode.
+// The connection is closed if the association fails, so that the next operation attempts it again.
+func associateAutomatically() error {
+	if remaining := associationCooldown - time.Since(lastDisassociation); remaining > 0 {
+		return fmt.Errorf("association cooldown has %v remaining", remaining)
+	}
+
+	if err := connectPFCPSim(); err != nil {
+		return err
+	}
+
+	if err := sim.SetupAssociation(); err != nil {
+		sim.DisconnectN4()
+		remotePeerConnected = false
+
+		return err
+	}
+
+	log.Info("Association automatically established")
+
+	return nil
+}
+
+// setupLoopbackAssociation connects to the emulated peer and sets up the association, if not done yet.
+func setupLoopbackAssociation() error {
+	if isRemotePeerConnected() && sim.IsAssociationAlive() {
+		return nil
+	}
+
+	if err := connectPFCPSim(); err != nil {
+		return err
+	}
+
+	return sim.SetupAssociation()
+}
+
+// waitAssociationCooldown blocks until the association cooldown following the last disassociation has elapsed.
+// Returns error if ctx is done meanwhile.
+func waitAssociationCooldown(ctx context.Context) error {
+	remaining := associationCooldown - time.Since(lastDisassociation)
+	if remaining <= 0 {
+		return nil
+	}
+
+	log.Infof("Waiting %v before associating again", remaining)
+
+	select {
+	case <-time.After(remaining):
+		return nil
+	case <-ctx.Done():
+		return status.Error(codes.Aborted, fmt.Sprintf("Association cooldown interrupted: %v", ctx.Err()))
+	}
+}
+
+// waitOverload blocks until the overload signaled by the remote peer expires, if throttling on overload is enabled.
+// Returns error if ctx is done meanwhile.
+func waitOverload(ctx context.Context) error {
+	if !throttleOnOverload {
+		return nil
+	}
+
+	overload := sim.OverloadControl()
+	if !overload.IsActive() {
+		return nil
+	}
+
+	log.Warnf("Remote peer is overloaded (reduction metric: %v%%). Waiting until %v before creating sessions",
+		overload.ReductionMetric, overload.ExpiresAt())
+
+	select {
+	case <-time.After(time.Until(overload.ExpiresAt())):
+		return nil
+	case <-ctx.Done():
+		return operationError(ctx, codes.Aborted, ctx.Err())
+	}
+}
+
+// waitPostCreateDelay blocks for delay once sessions are established, letting the remote peer finish provisioning them.
+// Returns error if ctx is done meanwhile, although the sessions remain established.
+func waitPostCreateDelay(ctx context.Context, delay time.Duration) error {
+	if delay == 0 {
+		return nil
+	}
+
+	log.Debugf("Waiting %v for the remote peer to provision the sessions", delay)
+
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-ctx.Done():
+		return operationError(ctx, codes.Aborted, fmt.Errorf("sessions are established, but the post-create delay was interrupted: %v", ctx.Err()))
+	}
+}
+
 func isConfigured() bool {
+	if assumeAssociated {
+		return true
+	}
+
 	if upfN3Address != "" && remotePeerAddress != "" {
 		return true
 	}
@@ -51,8 +390,145 @@ func isRemotePeerConnected() bool {
 	return remotePeerConnected
 }
 
+// checkDraining returns Unavailable error if the server is draining.
+func checkDraining() error {
+	if draining {
+		return status.Error(codes.Unavailable, "Server is draining: no new session is accepted")
+	}
+
+	return nil
+}
+
+// withOperationBudget returns a copy of ctx whose deadline is capped by the budget carried in the RPC metadata, if any.
+// The resulting deadline also bounds the wait for PFCP responses. The returned function must be called once the RPC completes.
+func withOperationBudget(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	cancel := func() {}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(operationBudgetMetadataKey)) > 0 {
+		value := md.Get(operationBudgetMetadataKey)[0]
+
+		budget, err := time.ParseDuration(value)
+		if err != nil || budget <= 0 {
+			errMsg := fmt.Sprintf("Invalid %v metadata: %v", operationBudgetMetadataKey, value)
+			log.Error(errMsg)
+			return nil, nil, status.Error(codes.Aborted, errMsg)
+		}
+
+		ctx, cancel = context.WithTimeout(ctx, budget)
+	}
+
+	return ctx, cancel, nil
+}
+
+// operationError returns the status error of a failed PFCP operation.
+// If the operation failed because the RPC deadline expired, DeadlineExceeded is returned instead of code.
+func operationError(ctx context.Context, code codes.Code, err error) error {
+	// the response timer may fire just before ctx is cancelled, hence the deadline is checked instead of ctx.Err()
+	if deadline, ok := ctx.Deadline(); ok && !time.Now().Before(deadline) {
+		log.Errorf("Operation budget expired: %v", err)
+		return status.Error(codes.DeadlineExceeded, fmt.Sprintf("Operation budget expired: %v", err))
+	}
+
+	log.Error(err.Error())
+
+	return status.Error(code, err.Error())
+}
+
+// newBatchError returns the status error of a session RPC which failed on the session with the given ID, after
+// succeeded sessions were processed, skipped sessions excluded. The status reports the progress of the batch,
+// also attached as a BatchFailure detail.
+func newBatchError(err error, succeeded int, failedSessionID int) error {
+	st := status.Convert(err)
+
+	failure := &pb.BatchFailure{
+		Succeeded:       int32(succeeded),
+		FailedIndex:     int32(succeeded + 1),
+		FailedSessionID: int32(failedSessionID),
+		Cause:           st.Message(),
+	}
+
+	errMsg := fmt.Sprintf("%v sessions succeeded; session %v (ID %v) failed: %v",
+		failure.Succeeded, failure.FailedIndex, failure.FailedSessionID, failure.Cause)
+
+	detailed, detailErr := status.New(st.Code(), errMsg).WithDetails(failure)
+	if detailErr != nil {
+		return status.Error(st.Code(), errMsg)
+	}
+
+	return detailed.Err()
+}
+
+// modificationStep is a modification of the downlink FARs applied to all the sessions of a ModifySession request.
+type modificationStep struct {
+	actions uint8
+	// delay is the time to wait before applying the step
+	delay time.Duration
+}
+
+// toModificationSteps returns the steps of a modification sequence, or a single step applying actions
+// if sequence is empty. Returns error if the action of a step is neither FORWARD nor BUFFER.
+func toModificationSteps(actions uint8, sequence []*pb.ModificationStep) ([]modificationStep, error) {
+	if len(sequence) == 0 {
+		return []modificationStep{{actions: actions}}, nil
+	}
+
+	steps := make([]modificationStep, 0, len(sequence))
+
+	for i, step := range sequence {
+		var stepActions uint8
+
+		switch step.Action {
+		case pb.FARAction_FORWARD:
+			stepActions = session.ActionForward
+		case pb.FARAction_BUFFER:
+			// buffering notifies the CP function, as with the buffer and notifyCP flags
+			stepActions = session.ActionBuffer | session.ActionNotify
+		default:
+			errMsg := fmt.Sprintf("Modification step %v: action must be FORWARD or BUFFER, got %v", i+1, step.Action)
+			log.Error(errMsg)
+			return nil, status.Error(codes.Aborted, errMsg)
+		}
+
+		steps = append(steps, modificationStep{
+			actions: stepActions,
+			delay:   time.Duration(step.DelayMs) * time.Millisecond,
+		})
+	}
+
+	return steps, nil
+}
+
 // isNumOfAppFiltersCorrect returns error if the number of the passed filter exceed the max number of supported application filters.
-func isNumOfAppFiltersCorrect(filters []string) error {
+// appFilterSubFlowSeparator separates the sub-flows of an application filter (e.g. "udp:any:80-80:allow:100,udp:any:81-81:deny:100").
+const appFilterSubFlowSeparator = ","
+
+// appFlow is the traffic matched by a pair of uplink and downlink PDRs.
+type appFlow struct {
+	filter string
+	// gated is true if the flow is a sub-flow of an application filter. The gate status of sub-flows is enforced
+	// by dedicated application QERs, referenced by their PDRs along with the session QER.
+	gated bool
+}
+
+// getAppFlows splits the application filters into the flows to create PDRs for.
+func getAppFlows(filters []string) []appFlow {
+	var flows []appFlow
+
+	for _, filter := range filters {
+		subFlows := strings.Split(filter, appFilterSubFlowSeparator)
+
+		for _, subFlow := range subFlows {
+			flows = append(flows, appFlow{
+				filter: subFlow,
+				gated:  len(subFlows) > 1,
+			})
+		}
+	}
+
+	return flows
+}
+
+func isNumOfAppFiltersCorrect(filters []appFlow) error {
 	if len(filters) > SessionStep/2 {
 		log.Errorf("Too many application filters: %v", filters)
 		return status.Error(codes.Aborted, "Too many application filters")
@@ -61,6 +537,418 @@ func isNumOfAppFiltersCorrect(filters []string) error {
 	return nil
 }
 
+// isIPv6PrefixPoolCorrect returns error if count IPv6 prefixes of prefixLength cannot be allocated from pool.
+func isIPv6PrefixPoolCorrect(pool *net.IPNet, prefixLength int, count int) error {
+	poolLength, bits := pool.Mask.Size()
+	if bits != net.IPv6len*8 {
+		log.Errorf("IPv6 prefix delegation requires an IPv6 address pool: %v", pool)
+		return status.Error(codes.Aborted, "IPv6 prefix delegation requires an IPv6 address pool")
+	}
+
+	if prefixLength <= poolLength || prefixLength > bits {
+		errMsg := fmt.Sprintf("IPv6 prefix length must be between %v and %v. Provided length: %v", poolLength+1, bits, prefixLength)
+		log.Error(errMsg)
+		return status.Error(codes.Aborted, errMsg)
+	}
+
+	if available := prefixLength - poolLength; available < 32 && count > 1<<available {
+		errMsg := fmt.Sprintf("Address pool %v is too small to allocate %v /%v prefixes", pool, count, prefixLength)
+		log.Error(errMsg)
+		return status.Error(codes.Aborted, errMsg)
+	}
+
+	return nil
+}
+
+// getNextIPv6Prefix returns the IPv6 prefix of prefixLength following prefix.
+func getNextIPv6Prefix(prefix net.IP, prefixLength int) net.IP {
+	return iplib.IncrementIP6By(prefix, new(big.Int).Lsh(big.NewInt(1), uint(net.IPv6len*8-prefixLength)))
+}
+
+// isSessionQERIDCorrect returns error if the session QER ID collides with the IDs of the application QERs.
+// The application QERs of the session with index i use the IDs from i to i+2*numAppFilters-1.
+func isSessionQERIDCorrect(sessQerID uint32, baseID int, count int, numAppFilters int) error {
+	for i := baseID; i < (count*SessionStep + baseID); i = i + SessionStep {
+		if sessQerID >= uint32(i) && sessQerID < uint32(i+2*numAppFilters) {
+			errMsg := fmt.Sprintf("Session QER ID %v collides with the application QER IDs of session %v", sessQerID, i)
+			log.Error(errMsg)
+			return status.Error(codes.Aborted, errMsg)
+		}
+	}
+
+	return nil
+}
+
+// getSessionRuleStatus returns the provisioning status of the PDRs of the session with index i. A PDR is confirmed
+// if the remote peer returned a Created PDR IE for it, along with the resources it allocated.
+func getSessionRuleStatus(i int, pdrs []*ie.IE, created []pfcpsim.CreatedPDR) *pb.SessionRuleStatus {
+	confirmed := make(map[uint16]pfcpsim.CreatedPDR, len(created))
+	for _, pdr := range created {
+		confirmed[pdr.ID] = pdr
+	}
+
+	sessionStatus := &pb.SessionRuleStatus{SessionID: int32(i)}
+
+	for _, pdr := range pdrs {
+		id, err := pdr.PDRID()
+		if err != nil {
+			continue
+		}
+
+		ruleStatus := &pb.RuleStatus{PdrID: uint32(id)}
+
+		if createdPDR, ok := confirmed[id]; ok {
+			ruleStatus.Confirmed = true
+			ruleStatus.Teid = createdPDR.TEID
+			ruleStatus.N3Address = createdPDR.N3Address
+			ruleStatus.UeAddress = createdPDR.UEAddress
+		}
+
+		sessionStatus.Rules = append(sessionStatus.Rules, ruleStatus)
+	}
+
+	return sessionStatus
+}
+
+// isRuleIDsCorrect returns error if the explicit rule IDs are not provided for each of the numAppFlows application flows,
+// or if a PDR ID does not fit 16 bits.
+func isRuleIDsCorrect(ruleIDs []*pb.RuleIDs, numAppFlows int) error {
+	if len(ruleIDs) != numAppFlows {
+		errMsg := fmt.Sprintf("Explicit rule IDs must be provided for each of the %v application flows. Provided rule IDs: %v", numAppFlows, len(ruleIDs))
+		log.Error(errMsg)
+		return status.Error(codes.Aborted, errMsg)
+	}
+
+	for _, ids := range ruleIDs {
+		if ids.UplinkPDRID > math.MaxUint16 || ids.DownlinkPDRID > math.MaxUint16 {
+			errMsg := fmt.Sprintf("PDR IDs must not be greater than %v. Provided PDR IDs: %v, %v", math.MaxUint16, ids.UplinkPDRID, ids.DownlinkPDRID)
+			log.Error(errMsg)
+			return status.Error(codes.Aborted, errMsg)
+		}
+	}
+
+	return nil
+}
+
+// checkRuleIDCollisions returns error if two PDRs, two FARs or two QERs of the session with index i have the same ID.
+func checkRuleIDCollisions(i int, pdrs []*ie.IE, fars []*ie.IE, qers []*ie.IE) error {
+	ruleSets := []struct {
+		kind  string
+		rules []*ie.IE
+		getID func(*ie.IE) (uint32, error)
+	}{
+		{"PDR", pdrs, func(pdr *ie.IE) (uint32, error) {
+			id, err := pdr.PDRID()
+			return uint32(id), err
+		}},
+		{"FAR", fars, (*ie.IE).FARID},
+		{"QER", qers, (*ie.IE).QERID},
+	}
+
+	for _, ruleSet := range ruleSets {
+		ids := make(map[uint32]bool)
+
+		for _, rule := range ruleSet.rules {
+			id, err := ruleSet.getID(rule)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			if ids[id] {
+				errMsg := fmt.Sprintf("%v ID %v is used by several rules of session %v", ruleSet.kind, id, i)
+				log.Error(errMsg)
+				return status.Error(codes.Aborted, errMsg)
+			}
+
+			ids[id] = true
+		}
+	}
+
+	return nil
+}
+
+// getAppFlowDownlinkFARIDs returns the IDs of the downlink FARs of the first numAppFlows application flows of a session,
+// given the FARs it was established with: an uplink and a downlink FAR per application flow, in this order.
+func getAppFlowDownlinkFARIDs(fars []*ie.IE, numAppFlows int) ([]uint32, error) {
+	var ids []uint32
+
+	for j := 0; j < numAppFlows && 2*j+1 < len(fars); j++ {
+		id, err := fars[2*j+1].FARID()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// isRuleIDOffsetCorrect returns error if, once shifted by ruleIDOffset, the rule IDs of the sessions do not fit the 16 bits of PDR IDs.
+func isRuleIDOffsetCorrect(ruleIDOffset uint32, baseID int, count int) error {
+	if uint64(ruleIDOffset)+uint64(count*SessionStep+baseID) > math.MaxUint16 {
+		errMsg := fmt.Sprintf("Rule ID offset %v is too high for %v sessions using %v as baseID", ruleIDOffset, count, baseID)
+		log.Error(errMsg)
+		return status.Error(codes.Aborted, errMsg)
+	}
+
+	return nil
+}
+
+// Default parameters of the self-test session.
+const (
+	selfTestNodeBAddress  = "10.0.0.1"
+	selfTestUEAddressPool = "17.0.0.0/24"
+	selfTestAppFilter     = "ip:any:any:allow:100"
+)
+
+// getFreeSessionID returns the first session ID not used by any active session.
+func getFreeSessionID() int {
+	id := 1
+	for _, ok := getSession(id); ok; _, ok = getSession(id) {
+		id += SessionStep
+	}
+
+	return id
+}
+
+// runSelfTestStep runs step, appending its outcome and duration to response.
+// Returns true if the step passed.
+func runSelfTestStep(response *pb.SelfTestResponse, name string, step func() error) bool {
+	start := time.Now()
+	err := step()
+
+	result := &pb.SelfTestStep{
+		Name:       name,
+		Passed:     err == nil,
+		DurationMs: uint64(time.Since(start).Milliseconds()),
+	}
+
+	if err != nil {
+		result.Error = err.Error()
+		log.Errorf("Self-test step %v failed: %v", name, err)
+	}
+
+	response.Steps = append(response.Steps, result)
+
+	return err == nil
+}
+
+// getHoldTime returns the hold time of a session, drawn from the distribution described by holdTime.
+func getHoldTime(holdTime *pb.HoldTime) time.Duration {
+	mean := time.Duration(holdTime.DurationMs) * time.Millisecond
+
+	if holdTime.Distribution == pb.HoldTimeDistribution_EXPONENTIAL {
+		return time.Duration(rand.ExpFloat64() * float64(mean))
+	}
+
+	return mean
+}
+
+// scheduleSessionDeletion deletes the session with the given index once holdTime has elapsed,
+// unless it has been deleted meanwhile.
+func scheduleSessionDeletion(index int, sess *pfcpsim.PFCPSession, holdTime time.Duration) {
+	setSessionHoldTimer(index, startHoldTimer(holdTime, func() {
+		current, sessCtx, ok := getSessionWithContext(index)
+		if !ok || current != sess {
+			return
+		}
+
+		logger := log.WithFields(log.Fields{
+			"transactionID": sessCtx.transactionID,
+			"sessionID":     index,
+		})
+
+		if err := sim.DeleteSession(sess); err != nil {
+			logger.Warnf("Session with index %v was not deleted by the remote peer after its hold time: %v", index, err)
+		}
+
+		// the session may have been deleted, and the index reused, during the deletion
+		if !deleteSessionIfCurrent(index, sess) {
+			return
+		}
+
+		logger.Debugf("Session with index %v deleted after a hold time of %v", index, holdTime)
+	}))
+}
+
+// toKbps converts a bit rate expressed in unit to kbps, the unit of MBR and GBR IEs.
+// Returns error if the converted bit rate cannot be encoded.
+func toKbps(rate uint64, unit pb.BitRateUnit) (uint64, error) {
+	sessionUnit := session.Kbps
+
+	switch unit {
+	case pb.BitRateUnit_BPS:
+		sessionUnit = session.Bps
+	case pb.BitRateUnit_MBPS:
+		sessionUnit = session.Mbps
+	}
+
+	kbps, ok := session.ToKbps(rate, sessionUnit)
+	if !ok {
+		errMsg := fmt.Sprintf("Bit rate %v %v exceeds the maximum of %v kbps", rate, unit, session.MaxBitRate)
+		log.Error(errMsg)
+		return 0, status.Error(codes.Aborted, errMsg)
+	}
+
+	return kbps, nil
+}
+
+// getSessionBitRates returns the session QER bit rates of request, converted to kbps.
+func getSessionBitRates(request *pb.CreateSessionRequest) (ulMbr, dlMbr, ulGbr, dlGbr uint64, err error) {
+	if ulMbr, err = toKbps(request.UplinkMBR, request.BitRateUnit); err != nil {
+		return
+	}
+
+	if dlMbr, err = toKbps(request.DownlinkMBR, request.BitRateUnit); err != nil {
+		return
+	}
+
+	if ulGbr, err = toKbps(request.UplinkGBR, request.BitRateUnit); err != nil {
+		return
+	}
+
+	dlGbr, err = toKbps(request.DownlinkGBR, request.BitRateUnit)
+
+	return
+}
+
+// toApplyAction converts a FARAction to the Apply Action flags of a FAR. Defaults to forward.
+func toApplyAction(action pb.FARAction) uint8 {
+	switch action {
+	case pb.FARAction_DROP:
+		return session.ActionDrop
+	case pb.FARAction_BUFFER:
+		return session.ActionBuffer
+	default:
+		return session.ActionForward
+	}
+}
+
+// newDefaultRules returns the fallback PDRs and FARs of a session, matching any traffic not matched by the application filters.
+// Rules are created only for the directions whose action is set. The uplink rules use id as PDR/FAR ID, the downlink ones id+1.
+func newDefaultRules(id uint16, teid uint32, qerID uint32, ueAddress string, uePrefixLength uint8, nodeBAddress string,
+	uplinkAction pb.FARAction, downlinkAction pb.FARAction, trafficEndpointID uint8, dnn string) ([]*ie.IE, []*ie.IE) {
+	var pdrs, fars []*ie.IE
+
+	if uplinkAction != pb.FARAction_ACTION_UNSPECIFIED {
+		pdrs = append(pdrs, session.NewPDRBuilder().
+			WithID(id).
+			WithMethod(session.Create).
+			WithTEID(teid).
+			WithFARID(uint32(id)).
+			AddQERID(qerID).
+			WithN3Address(upfN3Address).
+			WithPrecedence(defaultRulesPrecedence).
+			WithTrafficEndpointID(trafficEndpointID).
+			MarkAsUplink().
+			BuildPDR())
+
+		fars = append(fars, session.NewFARBuilder().
+			WithID(uint32(id)).
+			WithAction(toApplyAction(uplinkAction)).
+			WithDstInterface(ie.DstInterfaceCore).
+			WithMethod(session.Create).
+			WithNetworkInstance(dnn).
+			BuildFAR())
+	}
+
+	if downlinkAction != pb.FARAction_ACTION_UNSPECIFIED {
+		pdrs = append(pdrs, session.NewPDRBuilder().
+			WithID(id+1).
+			WithMethod(session.Create).
+			WithPrecedence(defaultRulesPrecedence).
+			WithUEAddress(ueAddress).
+			WithUEPrefixLength(uePrefixLength).
+			AddQERID(qerID).
+			WithFARID(uint32(id+1)).
+			WithTrafficEndpointID(trafficEndpointID).
+			WithNetworkInstance(dnn).
+			MarkAsDownlink().
+			BuildPDR())
+
+		downlinkFAR := session.NewFARBuilder().
+			WithID(uint32(id + 1)).
+			WithAction(toApplyAction(downlinkAction)).
+			WithMethod(session.Create).
+			WithDstInterface(ie.DstInterfaceAccess)
+
+		if downlinkAction == pb.FARAction_FORWARD {
+			downlinkFAR.WithTEID(teid).WithDownlinkIP(nodeBAddress)
+		}
+
+		fars = append(fars, downlinkFAR.BuildFAR())
+	}
+
+	return pdrs, fars
+}
+
+// Sources of the local address, by order of precedence (see selectLocalAddress).
+const (
+	localAddressExplicit  = "explicit address"
+	localAddressInterface = "address of the interface"
+	localAddressAuto      = "selected by the OS"
+)
+
+// SetLocalAddress sets the local address used with the remote peer, and advertised as Node ID.
+// It takes precedence over the address of the interface. It applies to the connections established afterwards.
+func SetLocalAddress(address string) {
+	localAddress = address
+}
+
+// selectLocalAddress returns the local address used with the remote peer, along with how it was selected.
+// The explicit address takes precedence over the address of the interface. If none is set, the address
+// the OS routes packets to the remote peer from is used, or the first non-loopback address if there is no route.
+func selectLocalAddress(explicitAddress string, iface string, remoteAddress string) (net.IP, string, error) {
+	if explicitAddress != "" {
+		ip := net.ParseIP(explicitAddress)
+		if ip == nil {
+			return nil, "", pfcpsim.NewInvalidFormatError(fmt.Sprintf("Local address %v", explicitAddress))
+		}
+
+		return ip, localAddressExplicit, nil
+	}
+
+	if iface != "" {
+		ip, err := getLocalAddress(iface)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return ip, localAddressInterface, nil
+	}
+
+	if ip, err := getRouteLocalAddress(remoteAddress); err == nil {
+		return ip, localAddressAuto, nil
+	}
+
+	ip, err := getLocalAddress("")
+	if err != nil {
+		return nil, "", err
+	}
+
+	return ip, localAddressAuto, nil
+}
+
+// getRouteLocalAddress returns the source address the OS selects to send packets to remoteAddress.
+// No packet is sent.
+func getRouteLocalAddress(remoteAddress string) (net.IP, error) {
+	addr := net.JoinHostPort(remoteAddress, strconv.Itoa(pfcpsim.PFCPStandardPort))
+
+	if _, _, err := net.SplitHostPort(remoteAddress); err == nil {
+		// remoteAddress contains also a port
+		addr = remoteAddress
+	}
+
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	return conn.LocalAddr().(*net.UDPAddr).IP, nil
+}
+
 // getLocalAddress returns the first IP address of the interfaceName, if specified,
 // otherwise returns the IP address of the first non-loopback interface
 // Returns error if fail occurs at any stage.
@@ -92,71 +980,376 @@ func getLocalAddress(interfaceName string) (net.IP, error) {
 	return nil, pfcpsim.NewNoValidInterfaceError()
 }
 
-// parseAppFilter parses an application filter. Returns a tuple formed by a formatted SDF filter
-// and a uint8 representing the Application QER gate status and a precedence. Returns error if fail occurs while validating the filter string.
-func parseAppFilter(filter string) (string, uint8, uint32, error) {
+// parseAppFilter parses an application filter. Returns a tuple formed by a formatted SDF filter,
+// a uint8 representing the Application QER gate status, the action of the FARs and a precedence.
+// A deny filter is enforced by a closed gate, whereas a drop filter is enforced by dropping FARs.
+// Returns error if fail occurs while validating the filter string.
+// The validation depends on validationStrictness: LENIENT mode ignores letter case and surrounding spaces,
+// STRICT mode rejects out of range ports and precedences and IP prefixes having host bits set.
+func parseAppFilter(filter string) (string, uint8, uint8, uint32, error) {
+	if validationStrictness == pb.Strictness_LENIENT {
+		filter = strings.ToLower(strings.ReplaceAll(filter, " ", ""))
+	}
+
 	if filter == "" {
 		// parsing a wildcard app filter
-		return "", ie.GateStatusOpen, 100, nil
+		return "", ie.GateStatusOpen, session.ActionForward, 100, nil
 	}
 
 	result := strings.Split(filter, ":")
 	if len(result) != 5 {
-		return "", 0, 0, pfcpsim.NewInvalidFormatError("Parser was not able to generate the correct number of arguments." +
+		return "", 0, 0, 0, pfcpsim.NewInvalidFormatError("Parser was not able to generate the correct number of arguments." +
 			" Please make sure to use the right format")
 	}
 
 	proto, ipNetAddr, portRange, action, precedence := result[0], result[1], result[2], result[3], result[4]
 
-	var gateStatus uint8
+	gateStatus, farAction := ie.GateStatusOpen, session.ActionForward
 	switch action {
 	case "allow":
-		gateStatus = ie.GateStatusOpen
 	case "deny":
 		gateStatus = ie.GateStatusClosed
+	case "drop":
+		farAction = session.ActionDrop
 	default:
-		return "", 0, 0, pfcpsim.NewInvalidFormatError("Action. Please make sure to use 'allow' or 'deny'")
+		return "", 0, 0, 0, pfcpsim.NewInvalidFormatError("Action. Please make sure to use 'allow', 'deny' or 'drop'")
 	}
 
 	if !(proto == "ip" || proto == "udp" || proto == "tcp") {
-		return "", 0, 0, pfcpsim.NewInvalidFormatError("Unsupported or unknown protocol.")
+		return "", 0, 0, 0, pfcpsim.NewInvalidFormatError("Unsupported or unknown protocol.")
 	}
 
 	precedenceConverted, err := strconv.Atoi(precedence)
 	if err != nil {
-		return "", 0, 0, pfcpsim.NewInvalidFormatError("Precedence. Please make sure it is a number", err)
+		return "", 0, 0, 0, pfcpsim.NewInvalidFormatError("Precedence. Please make sure it is a number", err)
+	}
+
+	if validationStrictness == pb.Strictness_STRICT {
+		if _, err := strconv.ParseUint(precedence, 10, 32); err != nil || precedenceConverted == 0 {
+			return "", 0, 0, 0, pfcpsim.NewInvalidFormatError("Precedence. Please make sure it is a positive 32-bit number")
+		}
 	}
 
 	precedenceUint := uint32(precedenceConverted)
 
 	if ipNetAddr != "any" {
-		_, _, err := net.ParseCIDR(ipNetAddr)
+		ip, ipNet, err := net.ParseCIDR(ipNetAddr)
 		if err != nil {
-			return "", 0, 0, pfcpsim.NewInvalidFormatError("IP and subnet mask.", err)
+			return "", 0, 0, 0, pfcpsim.NewInvalidFormatError("IP and subnet mask.", err)
+		}
+
+		if validationStrictness == pb.Strictness_STRICT && !ip.Equal(ipNet.IP) {
+			return "", 0, 0, 0, pfcpsim.NewInvalidFormatError("IP and subnet mask. Host bits are set")
 		}
 	}
 
 	if portRange != "any" {
 		portList := strings.Split(portRange, "-")
 		if !(len(portList) == 2) {
-			return "", 0, 0, pfcpsim.NewInvalidFormatError("Port range. Please make sure to use dash '-' to separate the two ports")
+			return "", 0, 0, 0, pfcpsim.NewInvalidFormatError("Port range. Please make sure to use dash '-' to separate the two ports")
 		}
 
 		lowerPort, err := strconv.Atoi(portList[0])
 		if err != nil {
-			return "", 0, 0, pfcpsim.NewInvalidFormatError("Port range.", err)
+			return "", 0, 0, 0, pfcpsim.NewInvalidFormatError("Port range.", err)
 		}
 
 		upperPort, err := strconv.Atoi(portList[1])
 		if err != nil {
-			return "", 0, 0, pfcpsim.NewInvalidFormatError("Port range.", err)
+			return "", 0, 0, 0, pfcpsim.NewInvalidFormatError("Port range.", err)
 		}
 
 		if lowerPort > upperPort {
-			return "", 0, 0, pfcpsim.NewInvalidFormatError("Port range. Lower port is greater than upper port")
+			return "", 0, 0, 0, pfcpsim.NewInvalidFormatError("Port range. Lower port is greater than upper port")
+		}
+
+		if validationStrictness == pb.Strictness_STRICT && (lowerPort < 0 || upperPort > math.MaxUint16) {
+			return "", 0, 0, 0, pfcpsim.NewInvalidFormatError("Port range. Ports must be between 0 and 65535")
 		}
-		return fmt.Sprintf(sdfFilterFormatWPort, proto, ipNetAddr, lowerPort, upperPort), gateStatus, precedenceUint, nil
+		return fmt.Sprintf(sdfFilterFormatWPort, proto, ipNetAddr, lowerPort, upperPort), gateStatus, farAction, precedenceUint, nil
 	} else {
-		return fmt.Sprintf(sdfFilterFormatWOPort, proto, ipNetAddr), gateStatus, precedenceUint, nil
+		return fmt.Sprintf(sdfFilterFormatWOPort, proto, ipNetAddr), gateStatus, farAction, precedenceUint, nil
 	}
 }
+
+// onSequenceWraparound logs the wraparound of the PFCP sequence number, if enabled.
+func onSequenceWraparound() {
+	if logSequenceWraparound {
+		log.Infof("PFCP sequence number wrapped around from %v to 0", pfcpsim.MaxSequenceNumber)
+	}
+}
+
+// isTEIDRangeCorrect returns error if the TEID range is not valid. A nil range is valid: TEIDs are not constrained.
+func isTEIDRangeCorrect(teidRange *pb.TEIDRange) error {
+	if teidRange == nil {
+		return nil
+	}
+
+	if teidRange.Min == 0 || teidRange.Min > teidRange.Max {
+		errMsg := fmt.Sprintf("TEID range must be within 1 and %v, and its minimum cannot exceed its maximum: %v-%v",
+			uint32(math.MaxUint32), teidRange.Min, teidRange.Max)
+		log.Error(errMsg)
+
+		return status.Error(codes.Aborted, errMsg)
+	}
+
+	return nil
+}
+
+// teidAllocator allocates the uplink TEIDs of the sessions from the configured TEID range,
+// skipping the TEIDs used by the active sessions.
+type teidAllocator struct {
+	// next is the next TEID to try. It is wider than a TEID not to overflow past the maximum
+	next uint64
+	used map[uint32]bool
+}
+
+// newTEIDAllocator returns a teidAllocator, or nil if no TEID range is configured.
+func newTEIDAllocator() *teidAllocator {
+	if minTEID == 0 {
+		return nil
+	}
+
+	return &teidAllocator{
+		next: uint64(minTEID),
+		used: getUplinkTEIDs(),
+	}
+}
+
+// allocate returns the lowest TEID of the range which is not used yet.
+// Returns ResourceExhausted error if every TEID of the range is used.
+func (a *teidAllocator) allocate() (uint32, error) {
+	for ; a.next <= uint64(maxTEID); a.next++ {
+		teid := uint32(a.next)
+
+		if !a.used[teid] {
+			a.used[teid] = true
+			a.next++
+
+			return teid, nil
+		}
+	}
+
+	return 0, status.Error(codes.ResourceExhausted, fmt.Sprintf("TEID range %v-%v is exhausted", minTEID, maxTEID))
+}
+
+// isInactivityTimerCorrect returns error if the User Plane Inactivity Timer, expressed in milliseconds,
+// cannot be encoded in whole seconds. Zero is correct: the timer is not included.
+func isInactivityTimerCorrect(timerMs uint32) error {
+	if timerMs%1000 != 0 {
+		errMsg := fmt.Sprintf("User Plane Inactivity Timer must be a whole number of seconds: %v", time.Duration(timerMs)*time.Millisecond)
+		log.Error(errMsg)
+
+		return status.Error(codes.Aborted, errMsg)
+	}
+
+	return nil
+}
+
+// sessionDNN returns the DNN of the sessions: the one of the request if set, the default DNN otherwise.
+func sessionDNN(requestDNN string) string {
+	if requestDNN != "" {
+		return requestDNN
+	}
+
+	return defaultDNN
+}
+
+// maxTrafficSteeringPolicyIDLength is the maximum length of a Traffic Steering Policy Identifier, carried by the Forwarding Policy IE.
+const maxTrafficSteeringPolicyIDLength = 255
+
+// isTrafficSteeringCorrect returns error if a Traffic Steering Policy Identifier is too long to be encoded.
+func isTrafficSteeringCorrect(steering *pb.TrafficSteering) error {
+	for _, policyID := range []string{steering.GetUplinkPolicyID(), steering.GetDownlinkPolicyID()} {
+		if len(policyID) > maxTrafficSteeringPolicyIDLength {
+			errMsg := fmt.Sprintf("Traffic Steering Policy Identifier cannot be longer than %v characters: %v",
+				maxTrafficSteeringPolicyIDLength, policyID)
+			log.Error(errMsg)
+			return status.Error(codes.Aborted, errMsg)
+		}
+	}
+
+	return nil
+}
+
+// isMBSSpecCorrect returns error if the MBS multicast address is not an IP multicast address,
+// or if the source address is not valid.
+func isMBSSpecCorrect(spec *pb.MBSSpec) error {
+	if spec == nil {
+		return nil
+	}
+
+	if ip := net.ParseIP(spec.MulticastAddress); ip == nil || !ip.IsMulticast() {
+		errMsg := fmt.Sprintf("MBS multicast address %v is not an IP multicast address", spec.MulticastAddress)
+		log.Error(errMsg)
+		return status.Error(codes.Aborted, errMsg)
+	}
+
+	if spec.SourceAddress != "" && net.ParseIP(spec.SourceAddress) == nil {
+		errMsg := fmt.Sprintf("Error while parsing MBS source address: %v", spec.SourceAddress)
+		log.Error(errMsg)
+		return status.Error(codes.Aborted, errMsg)
+	}
+
+	return nil
+}
+
+// bumpRecoveryTimeStamp returns the recovery time stamp advertised after the simulator "restarts".
+func bumpRecoveryTimeStamp() time.Time {
+	if sim != nil {
+		return sim.BumpRecoveryTimeStamp()
+	}
+
+	// no client has been created yet: it will advertise its creation time anyway
+	return time.Now()
+}
+
+// isRuleCountWithinLimits returns ResourceExhausted error if a session with the given number of rules
+// exceeds the rule limits of the remote peer.
+func isRuleCountWithinLimits(pdrs int, fars int, qers int) error {
+	for _, rule := range []struct {
+		name  string
+		count int
+		max   uint32
+	}{
+		{"PDRs", pdrs, maxPDRsPerSession},
+		{"FARs", fars, maxFARsPerSession},
+		{"QERs", qers, maxQERsPerSession},
+	} {
+		if rule.max != 0 && rule.count > int(rule.max) {
+			errMsg := fmt.Sprintf("Sessions have %v %v, exceeding the limit of the remote peer: %v", rule.count, rule.name, rule.max)
+			log.Error(errMsg)
+			return status.Error(codes.ResourceExhausted, errMsg)
+		}
+	}
+
+	return nil
+}
+
+// toPDRInfo decodes the IEs of a Create PDR IE to a PDRInfo.
+func toPDRInfo(pdr *ie.IE) (*pb.PDRInfo, error) {
+	ies, err := pdr.CreatePDR()
+	if err != nil {
+		return nil, err
+	}
+
+	info := &pb.PDRInfo{}
+
+	for _, x := range ies {
+		switch x.Type {
+		case ie.PDRID:
+			var id uint16
+			id, err = x.PDRID()
+			info.Id = uint32(id)
+		case ie.Precedence:
+			info.Precedence, err = x.Precedence()
+		case ie.PDI:
+			var sourceInterface uint8
+			sourceInterface, err = x.SourceInterface()
+			info.SourceInterface = uint32(sourceInterface)
+
+			if sdfFilter, sdfErr := x.SDFFilter(); sdfErr == nil {
+				info.SdfFilter = sdfFilter.FlowDescription
+			}
+		case ie.FARID:
+			info.FarID, err = x.FARID()
+		case ie.QERID:
+			var id uint32
+			id, err = x.QERID()
+			info.QerIDs = append(info.QerIDs, id)
+		case ie.URRID:
+			var id uint32
+			id, err = x.URRID()
+			info.UrrIDs = append(info.UrrIDs, id)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+// toFARInfo decodes the IEs of a Create FAR IE to a FARInfo.
+func toFARInfo(far *ie.IE) (*pb.FARInfo, error) {
+	ies, err := far.CreateFAR()
+	if err != nil {
+		return nil, err
+	}
+
+	info := &pb.FARInfo{}
+
+	for _, x := range ies {
+		switch x.Type {
+		case ie.FARID:
+			info.Id, err = x.FARID()
+		case ie.ApplyAction:
+			var applyAction uint8
+			applyAction, err = x.ApplyAction()
+			info.ApplyAction = uint32(applyAction)
+		case ie.ForwardingParameters:
+			if ohc, ohcErr := x.OuterHeaderCreation(); ohcErr == nil {
+				info.OuterHeaderAddress = ohc.IPv4Address.String()
+				if ohc.IPv4Address == nil {
+					info.OuterHeaderAddress = ohc.IPv6Address.String()
+				}
+
+				info.OuterHeaderTEID = ohc.TEID
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+// toQERInfo decodes the IEs of a Create QER IE to a QERInfo.
+func toQERInfo(qer *ie.IE) (*pb.QERInfo, error) {
+	ies, err := qer.CreateQER()
+	if err != nil {
+		return nil, err
+	}
+
+	info := &pb.QERInfo{}
+
+	for _, x := range ies {
+		switch x.Type {
+		case ie.QERID:
+			info.Id, err = x.QERID()
+		case ie.QFI:
+			var qfi uint8
+			qfi, err = x.QFI()
+			info.Qfi = uint32(qfi)
+		case ie.MBR:
+			info.UplinkMBR, err = x.MBRUL()
+			if err == nil {
+				info.DownlinkMBR, err = x.MBRDL()
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+// newTransactionID returns a random (version 4) UUID, identifying a call in the logs.
+func newTransactionID() string {
+	uuid := make([]byte, 16)
+
+	if _, err := cryptorand.Read(uuid); err != nil {
+		// the transaction ID is only used for correlation: fall back to a pseudo-random one
+		rand.Read(uuid)
+	}
+
+	uuid[6] = (uuid[6] & 0x0f) | 0x40 // version 4
+	uuid[8] = (uuid[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:])
+}
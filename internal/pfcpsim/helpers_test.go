@@ -4,10 +4,18 @@
 package pfcpsim
 
 import (
+	"context"
+	"net"
 	"testing"
 
+	pb "github.com/infinitydon/pfcpsim/api"
+	"github.com/infinitydon/pfcpsim/pkg/pfcpsim/session"
 	"github.com/stretchr/testify/require"
 	"github.com/wmnsk/go-pfcp/ie"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 func Test_parseAppFilter(t *testing.T) {
@@ -18,6 +26,7 @@ func Test_parseAppFilter(t *testing.T) {
 	type want struct {
 		SDFFilter  string
 		gateStatus uint8
+		farAction  uint8
 		precedence uint32
 	}
 
@@ -34,6 +43,7 @@ func Test_parseAppFilter(t *testing.T) {
 			want: &want{
 				SDFFilter:  "permit out udp from 10.0.0.0/8 to assigned 80-80",
 				gateStatus: ie.GateStatusOpen,
+				farAction:  session.ActionForward,
 				precedence: 100,
 			},
 		},
@@ -44,6 +54,7 @@ func Test_parseAppFilter(t *testing.T) {
 			want: &want{
 				SDFFilter:  "permit out udp from 10.0.0.0/8 to assigned 80-80",
 				gateStatus: ie.GateStatusClosed,
+				farAction:  session.ActionForward,
 				precedence: 101,
 			},
 		},
@@ -54,6 +65,7 @@ func Test_parseAppFilter(t *testing.T) {
 			want: &want{
 				SDFFilter:  "permit out ip from 0.0.0.0/0 to assigned",
 				gateStatus: ie.GateStatusClosed,
+				farAction:  session.ActionForward,
 				precedence: 102,
 			},
 		},
@@ -64,6 +76,7 @@ func Test_parseAppFilter(t *testing.T) {
 			want: &want{
 				SDFFilter:  "permit out ip from any to assigned",
 				gateStatus: ie.GateStatusClosed,
+				farAction:  session.ActionForward,
 				precedence: 100,
 			},
 		},
@@ -74,6 +87,7 @@ func Test_parseAppFilter(t *testing.T) {
 			want: &want{
 				SDFFilter:  "permit out ip from any to assigned",
 				gateStatus: ie.GateStatusOpen,
+				farAction:  session.ActionForward,
 				precedence: 100,
 			},
 		},
@@ -84,9 +98,21 @@ func Test_parseAppFilter(t *testing.T) {
 			want: &want{
 				SDFFilter:  "permit out ip from 0.0.0.0/0 to assigned",
 				gateStatus: ie.GateStatusOpen,
+				farAction:  session.ActionForward,
 				precedence: 103,
 			},
 		},
+		{name: "Correct app filter with drop",
+			args: &args{
+				filterString: "tcp:10.0.0.0/8:80-80:drop:104",
+			},
+			want: &want{
+				SDFFilter:  "permit out tcp from 10.0.0.0/8 to assigned 80-80",
+				gateStatus: ie.GateStatusOpen,
+				farAction:  session.ActionDrop,
+				precedence: 104,
+			},
+		},
 		{name: "incorrect app filter bad protocol",
 			args: &args{
 				filterString: "test:10.0.0.0/8:80-80:allow",
@@ -120,7 +146,7 @@ func Test_parseAppFilter(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				filter, gateStatus, precedence, err := parseAppFilter(tt.args.filterString)
+				filter, gateStatus, farAction, precedence, err := parseAppFilter(tt.args.filterString)
 				if tt.wantErr {
 					require.Error(t, err)
 					return
@@ -128,8 +154,212 @@ func Test_parseAppFilter(t *testing.T) {
 
 				require.Equal(t, tt.want.SDFFilter, filter)
 				require.Equal(t, tt.want.gateStatus, gateStatus)
+				require.Equal(t, tt.want.farAction, farAction)
 				require.Equal(t, tt.want.precedence, precedence)
 			},
 		)
 	}
 }
+
+func Test_parseAppFilterStrictness(t *testing.T) {
+	defer func() { validationStrictness = pb.Strictness_NORMAL }()
+
+	tests := []struct {
+		name       string
+		filter     string
+		strictness pb.Strictness
+		wantErr    bool
+	}{
+		{name: "Host bits set in lenient mode",
+			filter:     "udp:10.0.0.1/8:80-80:allow:100",
+			strictness: pb.Strictness_LENIENT,
+		},
+		{name: "Host bits set in strict mode",
+			filter:     "udp:10.0.0.1/8:80-80:allow:100",
+			strictness: pb.Strictness_STRICT,
+			wantErr:    true,
+		},
+		{name: "Upper case in lenient mode",
+			filter:     "UDP:10.0.0.0/8:80-80:Allow:100",
+			strictness: pb.Strictness_LENIENT,
+		},
+		{name: "Upper case in normal mode",
+			filter:     "UDP:10.0.0.0/8:80-80:Allow:100",
+			strictness: pb.Strictness_NORMAL,
+			wantErr:    true,
+		},
+		{name: "Out of range port in normal mode",
+			filter:     "tcp:any:80-70000:allow:100",
+			strictness: pb.Strictness_NORMAL,
+		},
+		{name: "Out of range port in strict mode",
+			filter:     "tcp:any:80-70000:allow:100",
+			strictness: pb.Strictness_STRICT,
+			wantErr:    true,
+		},
+		{name: "Zero precedence in strict mode",
+			filter:     "ip:any:any:allow:0",
+			strictness: pb.Strictness_STRICT,
+			wantErr:    true,
+		},
+		{name: "Valid filter in strict mode",
+			filter:     "udp:10.0.0.0/8:80-88:deny:100",
+			strictness: pb.Strictness_STRICT,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				validationStrictness = tt.strictness
+
+				_, _, _, _, err := parseAppFilter(tt.filter)
+				if tt.wantErr {
+					require.Error(t, err)
+					return
+				}
+
+				require.NoError(t, err)
+			},
+		)
+	}
+}
+
+func Test_newDefaultRules(t *testing.T) {
+	upfN3Address = "198.18.0.1"
+	defer func() { upfN3Address = "" }()
+
+	pdrs, fars := newDefaultRules(11, 1, 0, "17.0.0.1", 0, "10.0.0.1", pb.FARAction_FORWARD, pb.FARAction_DROP, 0, "")
+	require.Len(t, pdrs, 2)
+	require.Len(t, fars, 2)
+
+	for i, expectedAction := range []uint8{session.ActionForward, session.ActionDrop} {
+		precedence, err := pdrs[i].Precedence()
+		require.NoError(t, err)
+		require.Equal(t, uint32(defaultRulesPrecedence), precedence)
+
+		farID, err := pdrs[i].FARID()
+		require.NoError(t, err)
+		require.Equal(t, uint32(11+i), farID)
+
+		farID, err = fars[i].FARID()
+		require.NoError(t, err)
+		require.Equal(t, uint32(11+i), farID)
+
+		action, err := fars[i].ApplyAction()
+		require.NoError(t, err)
+		require.Equal(t, expectedAction, action)
+	}
+
+	// only the downlink direction is set
+	pdrs, fars = newDefaultRules(11, 1, 0, "17.0.0.1", 0, "10.0.0.1", pb.FARAction_ACTION_UNSPECIFIED, pb.FARAction_BUFFER, 0, "")
+	require.Len(t, pdrs, 1)
+	require.Len(t, fars, 1)
+
+	action, err := fars[0].ApplyAction()
+	require.NoError(t, err)
+	require.Equal(t, session.ActionBuffer, action)
+}
+
+func Test_selectLocalAddress(t *testing.T) {
+	t.Run("explicit address takes precedence over the interface", func(t *testing.T) {
+		ip, source, err := selectLocalAddress("10.1.2.3", "lo", "127.0.0.1")
+		require.NoError(t, err)
+		require.Equal(t, "10.1.2.3", ip.String())
+		require.Equal(t, localAddressExplicit, source)
+
+		_, _, err = selectLocalAddress("not-an-address", "", "127.0.0.1")
+		require.Error(t, err)
+	})
+
+	t.Run("address of the interface", func(t *testing.T) {
+		ifaceName, ifaceAddress := firstNonLoopbackInterface(t)
+
+		ip, source, err := selectLocalAddress("", ifaceName, "127.0.0.1")
+		require.NoError(t, err)
+		require.Equal(t, ifaceAddress, ip.String())
+		require.Equal(t, localAddressInterface, source)
+
+		_, _, err = selectLocalAddress("", "no-such-interface", "127.0.0.1")
+		require.Error(t, err)
+	})
+
+	t.Run("address selected by the OS", func(t *testing.T) {
+		for _, remoteAddress := range []string{"127.0.0.1", "127.0.0.1:8888"} {
+			ip, source, err := selectLocalAddress("", "", remoteAddress)
+			require.NoError(t, err)
+			require.Equal(t, "127.0.0.1", ip.String())
+			require.Equal(t, localAddressAuto, source)
+		}
+	})
+}
+
+// firstNonLoopbackInterface returns the name and the first IPv4 address of an interface having a non-loopback IPv4 address.
+// The test is skipped if there is none.
+func firstNonLoopbackInterface(t *testing.T) (string, string) {
+	ifaces, err := net.Interfaces()
+	require.NoError(t, err)
+
+	for _, iface := range ifaces {
+		addrs, err := iface.Addrs()
+		if err != nil {
+			continue
+		}
+
+		for _, addr := range addrs {
+			if ipNet, ok := addr.(*net.IPNet); ok && !ipNet.IP.IsLoopback() && ipNet.IP.To4() != nil {
+				return iface.Name, ipNet.IP.String()
+			}
+		}
+	}
+
+	t.Skip("no interface with a non-loopback IPv4 address")
+
+	return "", ""
+}
+
+func TestMessageSizeServerOptions(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	const maxMsgSize = 8 * 1024 * 1024
+
+	// startServer returns a client of a server started with opts
+	startServer := func(opts ...grpc.ServerOption) pb.PFCPSimClient {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		grpcServer := grpc.NewServer(opts...)
+		pb.RegisterPFCPSimServer(grpcServer, service)
+
+		go func() { _ = grpcServer.Serve(lis) }()
+
+		t.Cleanup(grpcServer.Stop)
+
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(),
+			grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(maxMsgSize)))
+		require.NoError(t, err)
+
+		t.Cleanup(func() { conn.Close() })
+
+		return pb.NewPFCPSimClient(conn)
+	}
+
+	request := &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	}
+
+	// pad the request beyond the default 4MB limit with a field unknown to the server
+	padding := protowire.AppendTag(nil, 1000, protowire.BytesType)
+	padding = protowire.AppendBytes(padding, make([]byte, 5*1024*1024))
+	request.ProtoReflect().SetUnknown(padding)
+
+	_, err := startServer().CreateSession(context.Background(), request)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = startServer(MessageSizeServerOptions(maxMsgSize, maxMsgSize)...).CreateSession(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, 1, getSessionCount())
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InterceptorOptions toggles the built-in gRPC interceptors of the server.
+type InterceptorOptions struct {
+	// Recovery turns a panic while handling a RPC into an INTERNAL error, instead of crashing the server
+	Recovery bool
+	// Logging logs every RPC call with its result code and duration
+	Logging bool
+}
+
+// UnaryInterceptorChain returns the gRPC server option chaining the enabled built-in interceptors, then the custom ones
+// in order (e.g. the audit interceptor, or operator-provided rate limiting and authentication).
+// The recovery interceptor comes first, so that it also recovers from panics of the other interceptors.
+func UnaryInterceptorChain(options InterceptorOptions, custom ...grpc.UnaryServerInterceptor) grpc.ServerOption {
+	var interceptors []grpc.UnaryServerInterceptor
+
+	if options.Recovery {
+		interceptors = append(interceptors, NewRecoveryInterceptor())
+	}
+
+	if options.Logging {
+		interceptors = append(interceptors, NewLoggingInterceptor())
+	}
+
+	return grpc.ChainUnaryInterceptor(append(interceptors, custom...)...)
+}
+
+// NewRecoveryInterceptor returns a gRPC interceptor recovering from a panic while handling a RPC.
+// The panic is logged along with its stack trace, and the RPC fails with INTERNAL.
+func NewRecoveryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				errMsg := fmt.Sprintf("Internal error while handling %v: %v", info.FullMethod, r)
+				log.Errorf("%v\n%s", errMsg, debug.Stack())
+
+				resp, err = nil, status.Error(codes.Internal, errMsg)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// NewLoggingInterceptor returns a gRPC interceptor logging every RPC call with its caller, result code and duration.
+func NewLoggingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		log.WithFields(log.Fields{
+			"method":   info.FullMethod,
+			"caller":   getCaller(ctx),
+			"code":     status.Code(err).String(),
+			"duration": time.Since(start).String(),
+		}).Info("RPC handled")
+
+		return resp, err
+	}
+}
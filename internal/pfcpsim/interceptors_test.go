@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pb "github.com/infinitydon/pfcpsim/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	// emulates a bug in GetSessionCount
+	panicking := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if info.FullMethod == "/api.PFCPSim/GetSessionCount" {
+			panic("nil pointer dereference")
+		}
+
+		return handler(ctx, req)
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(UnaryInterceptorChain(InterceptorOptions{Recovery: true, Logging: true}, panicking))
+	pb.RegisterPFCPSimServer(grpcServer, service)
+
+	go func() { _ = grpcServer.Serve(lis) }()
+
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() })
+
+	client := pb.NewPFCPSimClient(conn)
+
+	_, err = client.GetSessionCount(context.Background(), &pb.EmptyRequest{})
+	require.Equal(t, codes.Internal, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), "nil pointer dereference")
+
+	// the server keeps handling RPCs
+	_, err = client.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, getSessionCount())
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	pb "github.com/infinitydon/pfcpsim/api"
+)
+
+// latencyHistogram records the establishment latency of the sessions of a batch, once a warm-up phase is over.
+// The sessions of the warm-up phase, which often suffer from cold caches on the remote peer, are excluded
+// from the percentiles to report steady-state numbers.
+type latencyHistogram struct {
+	// warmUpCount is the number of sessions of the warm-up phase
+	warmUpCount int
+	// warmUpDuration is the duration of the warm-up phase, from start
+	warmUpDuration time.Duration
+	start          time.Time
+
+	warmUpSessions int
+	samples        []time.Duration
+}
+
+// newLatencyHistogram returns a histogram whose warm-up phase lasts for warmUpCount sessions, and at least
+// for warmUpDuration from now. Zero values disable the warm-up phase.
+func newLatencyHistogram(warmUpCount int, warmUpDuration time.Duration) *latencyHistogram {
+	return &latencyHistogram{
+		warmUpCount:    warmUpCount,
+		warmUpDuration: warmUpDuration,
+		start:          time.Now(),
+	}
+}
+
+// record adds the latency of a session established at establishedAt, unless it belongs to the warm-up phase.
+func (h *latencyHistogram) record(latency time.Duration, establishedAt time.Time) {
+	if h.warmUpSessions+len(h.samples) < h.warmUpCount || establishedAt.Sub(h.start) < h.warmUpDuration {
+		h.warmUpSessions++
+		return
+	}
+
+	h.samples = append(h.samples, latency)
+}
+
+// percentile returns the latency p percent of the samples do not exceed, using the nearest-rank method.
+// Returns zero if there is no sample.
+func (h *latencyHistogram) percentile(p float64) time.Duration {
+	if len(h.samples) == 0 {
+		return 0
+	}
+
+	sorted := append([]time.Duration{}, h.samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+// report returns the latency percentiles of the samples.
+func (h *latencyHistogram) report() *pb.LatencyReport {
+	return &pb.LatencyReport{
+		Samples:        int32(len(h.samples)),
+		WarmUpSessions: int32(h.warmUpSessions),
+		P50Us:          uint64(h.percentile(50).Microseconds()),
+		P90Us:          uint64(h.percentile(90).Microseconds()),
+		P99Us:          uint64(h.percentile(99).Microseconds()),
+		MaxUs:          uint64(h.percentile(100).Microseconds()),
+	}
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/infinitydon/pfcpsim/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyHistogramWarmUp(t *testing.T) {
+	// the first 2 sessions are slow, as caches are cold
+	histogram := newLatencyHistogram(2, 0)
+
+	for _, latency := range []time.Duration{time.Second, 500 * time.Millisecond, 10 * time.Millisecond, 20 * time.Millisecond} {
+		histogram.record(latency, time.Now())
+	}
+
+	require.Equal(t, &pb.LatencyReport{
+		Samples:        2,
+		WarmUpSessions: 2,
+		P50Us:          10000,
+		P90Us:          20000,
+		P99Us:          20000,
+		MaxUs:          20000,
+	}, histogram.report())
+
+	// the sessions established during the first 100ms are excluded
+	histogram = newLatencyHistogram(0, 100*time.Millisecond)
+	histogram.record(time.Second, histogram.start.Add(50*time.Millisecond))
+	histogram.record(30*time.Millisecond, histogram.start.Add(150*time.Millisecond))
+
+	require.Equal(t, 30*time.Millisecond, histogram.percentile(99))
+	require.Equal(t, int32(1), histogram.report().WarmUpSessions)
+
+	// no sample is reported while warming up
+	histogram = newLatencyHistogram(5, 0)
+	histogram.record(time.Second, time.Now())
+
+	require.Equal(t, time.Duration(0), histogram.percentile(50))
+	require.Equal(t, int32(0), histogram.report().Samples)
+}
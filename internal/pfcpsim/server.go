@@ -6,7 +6,9 @@ package pfcpsim
 import (
         "context"
         "fmt"
+        "math"
         "net"
+        "time"
 
         "github.com/c-robinson/iplib"
         pb "github.com/infinitydon/pfcpsim/api"
@@ -28,18 +30,40 @@ type pfcpSimService struct{}
 // to deny traffic to the RFC1918 IPs, in case we have a ALLOW-PUBLIC)
 const SessionStep = 10
 
+// defaultDataPathCheckTimeout is the time to wait for the GTP-U Echo Response while checking the data path.
+const defaultDataPathCheckTimeout = time.Second
+
+// maxQFI is the highest QFI that can be encoded in the 6 bits of the QFI IE.
+const maxQFI = 63
+
 func NewPFCPSimService(iface string) *pfcpSimService {
         interfaceName = iface
         return &pfcpSimService{}
 }
 
 func checkServerStatus() error {
+        if assumeAssociated {
+                if err := setupLoopbackAssociation(); err != nil {
+                        return status.Error(codes.Aborted, err.Error())
+                }
+
+                return nil
+        }
+
         if !isConfigured() {
                 return status.Error(codes.Aborted, "Server is not configured")
         }
 
         if !isRemotePeerConnected() {
-                return status.Error(codes.Aborted, "Server is not associated")
+                if !autoAssociate {
+                        return status.Error(codes.Aborted, "Server is not associated")
+                }
+
+                if err := associateAutomatically(); err != nil {
+                        errMsg := fmt.Sprintf("Server is not associated and automatic association failed: %v", err)
+                        log.Error(errMsg)
+                        return status.Error(codes.Aborted, errMsg)
+                }
         }
 
         return nil
@@ -51,11 +75,96 @@ func (P pfcpSimService) Configure(ctx context.Context, request *pb.ConfigureRequ
                 log.Error(errMsg)
                 return &pb.Response{}, status.Error(codes.Aborted, errMsg)
         }
+
+        filter, err := toIEFilter(request.IncludedIETypes, request.ExcludedIETypes)
+        if err != nil {
+                log.Error(err)
+                return &pb.Response{}, status.Error(codes.Aborted, err.Error())
+        }
+
+        if err := isTEIDRangeCorrect(request.TeidRange); err != nil {
+                return &pb.Response{}, err
+        }
+
+        if err := isHonorOverloadReductionCorrect(request.HonorOverloadReduction); err != nil {
+                return &pb.Response{}, err
+        }
+
+        backoff := request.TimeoutBackoffMultiplier
+        if backoff == 0 {
+                backoff = 1
+        }
+
+        if backoff < 1 {
+                errMsg := fmt.Sprintf("Timeout backoff multiplier cannot be lower than 1: %v", request.TimeoutBackoffMultiplier)
+                log.Error(errMsg)
+                return &pb.Response{}, status.Error(codes.Aborted, errMsg)
+        }
+
+        timeout := pfcpsim.DefaultResponseTimeout
+        if request.ResponseTimeoutMs != 0 {
+                timeout = time.Duration(request.ResponseTimeoutMs) * time.Millisecond
+        }
+
         // remotePeerAddress is validated in pfcpsim
         remotePeerAddress = request.RemotePeerAddress
         upfN3Address = request.UpfN3Address
+        validationStrictness = request.Strictness
+        associationCooldown = time.Duration(request.AssociationCooldownMs) * time.Millisecond
+        throttleOnOverload = request.ThrottleOnOverload
+        honorOverloadReduction = request.HonorOverloadReduction
+        ieFilter = filter
+        nodeType = toNodeType(request.NodeType)
+        responseTimeout = timeout
+        retransmissions = int(request.Retransmissions)
+        timeoutBackoff = backoff
+        maxPDRsPerSession = request.RuleLimits.GetMaxPDRs()
+        maxFARsPerSession = request.RuleLimits.GetMaxFARs()
+        maxQERsPerSession = request.RuleLimits.GetMaxQERs()
+        minTEID = request.TeidRange.GetMin()
+        maxTEID = request.TeidRange.GetMax()
+        defaultDNN = request.DefaultDNN
+        logSequenceWraparound = request.LogSequenceWraparound
+
+        if request.RecoveryTimeStamp != 0 {
+                recoveryTimeStamp = time.Unix(request.RecoveryTimeStamp, 0)
+        }
+
+        if request.BumpRecoveryTimeStamp {
+                recoveryTimeStamp = bumpRecoveryTimeStamp()
+        }
+
+        if sim != nil {
+                sim.SetIEFilter(ieFilter)
+                sim.SetNodeType(nodeType)
+                sim.SetPFCPResponseTimeout(responseTimeout)
+                sim.SetRetransmissionPolicy(retransmissions, timeoutBackoff)
 
-        configurationMsg := fmt.Sprintf("Server is configured. Remote peer address: %v, N3 interface address: %v ", remotePeerAddress, upfN3Address)
+                if !recoveryTimeStamp.IsZero() {
+                        sim.SetRecoveryTimeStamp(recoveryTimeStamp)
+                }
+        }
+
+        configurationMsg := fmt.Sprintf("Server is configured. Remote peer address: %v, N3 interface address: %v, strictness: %v, association cooldown: %v, throttle on overload: %v, "+
+                "honor overload reduction: %v, node type: %v, response timeout: %v, retransmissions: %v, timeout backoff multiplier: %v ",
+                remotePeerAddress, upfN3Address, validationStrictness, associationCooldown, throttleOnOverload, honorOverloadReduction, nodeType,
+                responseTimeout, retransmissions, timeoutBackoff)
+
+        if !recoveryTimeStamp.IsZero() {
+                configurationMsg += fmt.Sprintf("recovery time stamp: %v ", recoveryTimeStamp)
+        }
+
+        if minTEID != 0 {
+                configurationMsg += fmt.Sprintf("TEID range: %v-%v ", minTEID, maxTEID)
+        }
+
+        if defaultDNN != "" {
+                configurationMsg += fmt.Sprintf("default DNN: %v ", defaultDNN)
+        }
+
+        if !ieFilter.IsEmpty() {
+                configurationMsg += fmt.Sprintf("included IE types: %v, excluded IE types: %v ", ieFilter.Include, ieFilter.Exclude)
+        }
         log.Info(configurationMsg)
 
         return &pb.Response{
@@ -70,6 +179,10 @@ func (P pfcpSimService) Associate(ctx context.Context, empty *pb.EmptyRequest) (
                 return &pb.Response{}, status.Error(codes.Aborted, "Server is not configured")
         }
 
+        if err := waitAssociationCooldown(ctx); err != nil {
+                return &pb.Response{}, err
+        }
+
         if !isRemotePeerConnected() {
                 if err := connectPFCPSim(); err != nil {
                         errMsg := fmt.Sprintf("Could not connect to remote peer :%v", err)
@@ -78,9 +191,14 @@ func (P pfcpSimService) Associate(ctx context.Context, empty *pb.EmptyRequest) (
                 }
         }
 
-        if err := sim.SetupAssociation(); err != nil {
-                log.Error(err.Error())
-                return &pb.Response{}, status.Error(codes.Aborted, err.Error())
+        ctx, cancel, err := withOperationBudget(ctx)
+        if err != nil {
+                return &pb.Response{}, err
+        }
+        defer cancel()
+
+        if err := sim.SetupAssociationContext(ctx); err != nil {
+                return &pb.Response{}, operationError(ctx, codes.Aborted, err)
         }
 
         infoMsg := "Association established"
@@ -97,14 +215,20 @@ func (P pfcpSimService) Disassociate(ctx context.Context, empty *pb.EmptyRequest
                 return &pb.Response{}, err
         }
 
-        if err := sim.TeardownAssociation(); err != nil {
-                log.Error(err.Error())
-                return &pb.Response{}, status.Error(codes.Aborted, err.Error())
+        ctx, cancel, err := withOperationBudget(ctx)
+        if err != nil {
+                return &pb.Response{}, err
+        }
+        defer cancel()
+
+        if err := sim.TeardownAssociationContext(ctx); err != nil {
+                return &pb.Response{}, operationError(ctx, codes.Aborted, err)
         }
 
         sim.DisconnectN4()
 
         remotePeerConnected = false
+        lastDisassociation = time.Now()
 
         infoMsg := "Association teardown completed and connection to remote peer closed"
         log.Info(infoMsg)
@@ -115,63 +239,303 @@ func (P pfcpSimService) Disassociate(ctx context.Context, empty *pb.EmptyRequest
         }, nil
 }
 
+func (P pfcpSimService) ReAssociate(ctx context.Context, empty *pb.EmptyRequest) (*pb.Response, error) {
+        if isRemotePeerConnected() {
+                if _, err := P.Disassociate(ctx, empty); err != nil {
+                        return &pb.Response{}, err
+                }
+        }
+
+        if _, err := P.Associate(ctx, empty); err != nil {
+                return &pb.Response{}, err
+        }
+
+        infoMsg := "Association re-established"
+        log.Info(infoMsg)
+
+        return &pb.Response{
+                StatusCode: int32(codes.OK),
+                Message:    infoMsg,
+        }, nil
+}
+
 func (P pfcpSimService) CreateSession(ctx context.Context, request *pb.CreateSessionRequest) (*pb.Response, error) {
+        return P.createSessions(ctx, request, nil)
+}
+
+// createSessions creates the sessions described by request. If created is not nil, the ID of each session
+// is appended to it once established, so that the caller knows which sessions to roll back on failure.
+func (P pfcpSimService) createSessions(ctx context.Context, request *pb.CreateSessionRequest, created *[]int) (*pb.Response, error) {
         if err := checkServerStatus(); err != nil {
                 return &pb.Response{}, err
         }
 
+        if err := checkDraining(); err != nil {
+                return &pb.Response{}, err
+        }
+
+        // transactionID correlates the messages logged while creating the sessions
+        transactionID := newTransactionID()
+        logger := log.WithField("transactionID", transactionID)
+
+        ctx, cancel, err := withOperationBudget(ctx)
+        if err != nil {
+                return &pb.Response{}, err
+        }
+        defer cancel()
+
         baseID := int(request.BaseID)
         count := int(request.Count)
         nodeBaddress := request.NodeBAddress
 
-        lastUEAddr, _, err := net.ParseCIDR(request.UeAddressPool)
+        lastUEAddr, uePool, err := net.ParseCIDR(request.UeAddressPool)
         if err != nil {
                 errMsg := fmt.Sprintf(" Could not parse Address Pool: %v", err)
-                log.Error(errMsg)
+                logger.Error(errMsg)
                 return &pb.Response{}, status.Error(codes.Aborted, errMsg)
         }
 
+        ueIPv6PrefixLength := int(request.UeIPv6PrefixLength)
+
+        if ueIPv6PrefixLength != 0 {
+                if err = isIPv6PrefixPoolCorrect(uePool, ueIPv6PrefixLength, count); err != nil {
+                        return &pb.Response{}, err
+                }
+        }
+
+        // prefixes are allocated starting from the first one of the pool
+        nextUEPrefix := uePool.IP
+
         var qfi uint8 = 0
 
+        if validationStrictness == pb.Strictness_STRICT && (request.Qfi < 0 || request.Qfi > maxQFI) {
+                errMsg := fmt.Sprintf("QFI must be between 0 and %v. Provided QFI: %v", maxQFI, request.Qfi)
+                logger.Error(errMsg)
+                return &pb.Response{}, status.Error(codes.Aborted, errMsg)
+        }
+
         if request.Qfi != 0 {
                 qfi = uint8(request.Qfi)
         }
 
-        if err = isNumOfAppFiltersCorrect(request.AppFilters); err != nil {
+        appFlows := getAppFlows(request.AppFilters)
+
+        if err = isNumOfAppFiltersCorrect(appFlows); err != nil {
+                return &pb.Response{}, err
+        }
+
+        if _, ok := session.GetFiveQICharacteristics(uint8(request.FiveQI)); request.FiveQI != 0 && (request.FiveQI < 0 || request.FiveQI > math.MaxUint8 || !ok) {
+                errMsg := fmt.Sprintf("5QI %v is not a standardized 5QI", request.FiveQI)
+                logger.Error(errMsg)
+                return &pb.Response{}, status.Error(codes.Aborted, errMsg)
+        }
+
+        withInitialDownlinkAction := request.InitialDownlinkAction == pb.FARAction_DROP ||
+                request.InitialDownlinkAction == pb.FARAction_BUFFER
+
+        withDefaultRules := request.UplinkDefaultAction != pb.FARAction_ACTION_UNSPECIFIED ||
+                request.DownlinkDefaultAction != pb.FARAction_ACTION_UNSPECIFIED
+
+        if withDefaultRules && len(appFlows) >= SessionStep/2 {
+                // default rules use the IDs of one application filter
+                logger.Errorf("Too many application filters to add default rules: %v", request.AppFilters)
+                return &pb.Response{}, status.Error(codes.Aborted, "Too many application filters to add default rules")
+        }
+
+        ulMbr, dlMbr, ulGbr, dlGbr, err := getSessionBitRates(request)
+        if err != nil {
                 return &pb.Response{}, err
         }
 
+        withExplicitRuleIDs := len(request.RuleIDs) != 0
+
+        if withExplicitRuleIDs {
+                // collisions are detected once the rules of each session are built
+                if err = isRuleIDsCorrect(request.RuleIDs, len(appFlows)); err != nil {
+                        return &pb.Response{}, err
+                }
+        } else if err = isSessionQERIDCorrect(request.SessionQerID, baseID, count, len(appFlows)); err != nil {
+                return &pb.Response{}, err
+        }
+
+        ruleIDOffset := request.RuleIDOffset
+
+        if err = isRuleIDOffsetCorrect(ruleIDOffset, baseID, count); err != nil {
+                return &pb.Response{}, err
+        }
+
+        if request.Urr != nil && !(request.Urr.Volume || request.Urr.Duration || request.Urr.Event) {
+                errMsg := "URR requires at least one measurement method"
+                logger.Error(errMsg)
+                return &pb.Response{}, status.Error(codes.Aborted, errMsg)
+        }
+
+        if err = isMBSSpecCorrect(request.Mbs); err != nil {
+                return &pb.Response{}, err
+        }
+
+        if err = isTrafficSteeringCorrect(request.TrafficSteering); err != nil {
+                return &pb.Response{}, err
+        }
+
+        if err = isInactivityTimerCorrect(request.InactivityTimerMs); err != nil {
+                return &pb.Response{}, err
+        }
+
+        dnn := sessionDNN(request.Dnn)
+
+        cpFSEIDAddress := sim.LocalAddress()
+
+        if request.CpFSEIDAddress != "" {
+                if net.ParseIP(request.CpFSEIDAddress) == nil {
+                        errMsg := fmt.Sprintf("Error while parsing CP F-SEID address: %v", request.CpFSEIDAddress)
+                        logger.Error(errMsg)
+                        return &pb.Response{}, status.Error(codes.Aborted, errMsg)
+                }
+
+                cpFSEIDAddress = request.CpFSEIDAddress
+        }
+
+        teids := newTEIDAllocator()
+
+        // batchUEAddresses are the UE addresses of the sessions of the batch, to detect duplicates
+        batchUEAddresses := make(map[string]bool)
+        // skipped is the number of sessions which were not created because of a duplicate UE address, with SKIP_DUPLICATES
+        skipped := 0
+        // established is the number of sessions established so far
+        established := 0
+        // ruleStatus is the provisioning status of the PDRs of the created sessions, if requested
+        var ruleStatus []*pb.SessionRuleStatus
+        // latency records the establishment latency of the sessions, if requested
+        var latency *latencyHistogram
+
+        if request.ReportLatency {
+                latency = newLatencyHistogram(int(request.WarmUpCount), time.Duration(request.WarmUpMs)*time.Millisecond)
+        }
+
         for i := baseID; i < (count*SessionStep + baseID); i = i + SessionStep {
+                if ctx.Err() != nil {
+                        return &pb.Response{}, newBatchError(operationError(ctx, codes.Aborted, ctx.Err()), established, i)
+                }
+
                 // using variables to ease comprehension on how rules are linked together
                 uplinkTEID := uint32(i)
 
-                ueAddress := iplib.NextIP(lastUEAddr)
-                lastUEAddr = ueAddress
+                if teids != nil {
+                        if uplinkTEID, err = teids.allocate(); err != nil {
+                                logger.Error(err)
+                                return &pb.Response{}, newBatchError(err, established, i)
+                        }
+                }
+
+                var ueAddress net.IP
+
+                if ueIPv6PrefixLength != 0 {
+                        ueAddress = nextUEPrefix
+                        nextUEPrefix = getNextIPv6Prefix(nextUEPrefix, ueIPv6PrefixLength)
+                } else {
+                        ueAddress = iplib.NextIP(lastUEAddr)
+                        lastUEAddr = ueAddress
+                }
+
+                sessionUEAddress := ueAddress.String()
+                if ueIPv6PrefixLength != 0 {
+                        sessionUEAddress = fmt.Sprintf("%v/%v", ueAddress, ueIPv6PrefixLength)
+                }
+
+                if request.DuplicateUEAddressPolicy != pb.DuplicateUEAddressPolicy_ALLOW_DUPLICATES {
+                        if batchUEAddresses[sessionUEAddress] || (request.CheckActiveUEAddresses && isUEAddressActive(sessionUEAddress)) {
+                                errMsg := fmt.Sprintf("UE address %v of session %v is already used", sessionUEAddress, i)
+
+                                if request.DuplicateUEAddressPolicy == pb.DuplicateUEAddressPolicy_SKIP_DUPLICATES {
+                                        logger.Warnf("%v: skipping session", errMsg)
+                                        skipped++
+
+                                        continue
+                                }
+
+                                logger.Error(errMsg)
+                                return &pb.Response{}, newBatchError(status.Error(codes.AlreadyExists, errMsg), established, i)
+                        }
+
+                        batchUEAddresses[sessionUEAddress] = true
+                }
+
+                sessQerID := request.SessionQerID + ruleIDOffset
+
+                var pdrs, fars, urrs []*ieLib.IE
+
+                // trafficEndpointID, if set, is referenced by the PDRs instead of the F-TEID and UE IP address
+                var (
+                        trafficEndpointID uint8
+                        trafficEndpoints  []*ieLib.IE
+                )
+
+                if request.TrafficEndpoint {
+                        trafficEndpointID = sharedTrafficEndpointID
+
+                        trafficEndpoints = append(trafficEndpoints, session.NewTrafficEndpointBuilder().
+                                WithID(trafficEndpointID).
+                                WithTEID(uplinkTEID).
+                                WithN3Address(upfN3Address).
+                                WithUEAddress(ueAddress.String()).
+                                WithUEPrefixLength(uint8(ueIPv6PrefixLength)).
+                                WithNetworkInstance(dnn).
+                                Build())
+                }
+
+                urrID := uint32(i) + ruleIDOffset
+
+                if request.Urr != nil {
+                        urrs = append(urrs, session.NewURRBuilder().
+                                WithID(urrID).
+                                WithMethod(session.Create).
+                                WithMeasurementMethod(request.Urr.Volume, request.Urr.Duration, request.Urr.Event).
+                                Build())
+                }
+
+                sessQERBuilder := session.NewQERBuilder().
+                        WithID(sessQerID).
+                        WithMethod(session.Create)
+
+                if request.FiveQI != 0 {
+                        // QFI, MBR and GBR are derived from the 5QI, unless explicitly provided
+                        sessQERBuilder.WithFiveQI(uint8(request.FiveQI)).WithQFI(qfi)
+                } else {
+                        sessQERBuilder.WithUplinkMBR(60000).WithDownlinkMBR(60000)
+                }
+
+                if ulMbr != 0 {
+                        sessQERBuilder.WithUplinkMBR(ulMbr)
+                }
 
-                sessQerID := uint32(0)
+                if dlMbr != 0 {
+                        sessQERBuilder.WithDownlinkMBR(dlMbr)
+                }
+
+                if ulGbr != 0 {
+                        sessQERBuilder.WithUplinkGBR(ulGbr)
+                }
 
-                var pdrs, fars []*ieLib.IE
+                if dlGbr != 0 {
+                        sessQERBuilder.WithDownlinkGBR(dlGbr)
+                }
 
                 qers := []*ieLib.IE{
                         // session QER
-                        session.NewQERBuilder().
-                                WithID(sessQerID).
-                                WithMethod(session.Create).
-                                WithUplinkMBR(60000).
-                                WithDownlinkMBR(60000).
-                                Build(),
+                        sessQERBuilder.Build(),
                 }
 
                 // create as many PDRs, FARs and App QERs as the number of app filters provided through pfcpctl
-                ID := uint16(i)
+                ID := uint16(i + int(ruleIDOffset))
 
-                for _, appFilter := range request.AppFilters {
-                        SDFFilter, gateStatus, precedence, err := parseAppFilter(appFilter)
+                for j, flow := range appFlows {
+                        SDFFilter, gateStatus, farAction, precedence, err := parseAppFilter(flow.filter)
                         if err != nil {
-                                return &pb.Response{}, status.Error(codes.Aborted, err.Error())
+                                return &pb.Response{}, newBatchError(status.Error(codes.Aborted, err.Error()), established, i)
                         }
 
-                        log.Infof("Successfully parsed application filter. SDF Filter: %v", SDFFilter)
+                        logger.Infof("Successfully parsed application filter. SDF Filter: %v", SDFFilter)
 
                         uplinkPdrID := ID
                         downlinkPdrID := ID + 1
@@ -182,7 +546,15 @@ func (P pfcpSimService) CreateSession(ctx context.Context, request *pb.CreateSes
                         uplinkAppQerID := uint32(ID)
                         downlinkAppQerID := uint32(ID + 1)
 
-                        uplinkPDR := session.NewPDRBuilder().
+                        if withExplicitRuleIDs {
+                                ruleIDs := request.RuleIDs[j]
+
+                                uplinkPdrID, downlinkPdrID = uint16(ruleIDs.UplinkPDRID), uint16(ruleIDs.DownlinkPDRID)
+                                uplinkFarID, downlinkFarID = ruleIDs.UplinkFARID, ruleIDs.DownlinkFARID
+                                uplinkAppQerID, downlinkAppQerID = ruleIDs.UplinkQERID, ruleIDs.DownlinkQERID
+                        }
+
+                        uplinkPDRBuilder := session.NewPDRBuilder().
                                 WithID(uplinkPdrID).
                                 WithMethod(session.Create).
                                 WithTEID(uplinkTEID).
@@ -191,43 +563,81 @@ func (P pfcpSimService) CreateSession(ctx context.Context, request *pb.CreateSes
                                 WithN3Address(upfN3Address).
                                 WithSDFFilter(SDFFilter).
                                 WithPrecedence(precedence).
-                                MarkAsUplink().
-                                BuildPDR()
+                                WithTrafficEndpointID(trafficEndpointID).
+                                MarkAsUplink()
 
-                        downlinkPDR := session.NewPDRBuilder().
+                        downlinkPDRBuilder := session.NewPDRBuilder().
                                 WithID(downlinkPdrID).
                                 WithMethod(session.Create).
                                 WithPrecedence(precedence).
                                 WithUEAddress(ueAddress.String()).
+                                WithUEPrefixLength(uint8(ueIPv6PrefixLength)).
                                 WithSDFFilter(SDFFilter).
                                 AddQERID(sessQerID).
                                 WithFARID(downlinkFarID).
-                                MarkAsDownlink().
-                                BuildPDR()
+                                WithTrafficEndpointID(trafficEndpointID).
+                                WithNetworkInstance(dnn).
+                                MarkAsDownlink()
+
+                        if request.Urr != nil {
+                                uplinkPDRBuilder.AddURRID(urrID)
+                                downlinkPDRBuilder.AddURRID(urrID)
+                        }
+
+                        for _, rule := range request.PredefinedRules {
+                                uplinkPDRBuilder.WithPredefinedRule(rule)
+                                downlinkPDRBuilder.WithPredefinedRule(rule)
+                        }
+
+                        if flow.gated {
+                                uplinkPDRBuilder.AddQERID(uplinkAppQerID)
+                                downlinkPDRBuilder.AddQERID(downlinkAppQerID)
+                        }
+
+                        if request.Mbs != nil {
+                                downlinkPDRBuilder.WithIPMulticastAddressingInfo(request.Mbs.MulticastAddress, request.Mbs.SourceAddress)
+                        }
+
+                        uplinkPDR := uplinkPDRBuilder.BuildPDR()
+                        downlinkPDR := downlinkPDRBuilder.BuildPDR()
 
                         pdrs = append(pdrs, uplinkPDR)
                         pdrs = append(pdrs, downlinkPDR)
 
                         uplinkFAR := session.NewFARBuilder().
                                 WithID(uplinkFarID).
-                                WithAction(session.ActionForward).
+                                WithAction(farAction).
                                 WithDstInterface(ieLib.DstInterfaceCore).
                                 WithMethod(session.Create).
+                                WithNetworkInstance(dnn).
+                                WithTrafficSteering(request.TrafficSteering.GetUplinkPolicyID(), request.TrafficSteering.GetDownlinkPolicyID()).
                                 BuildFAR()
 
-                        downlinkFAR := session.NewFARBuilder().
+                        downlinkFARBuilder := session.NewFARBuilder().
                                 WithID(downlinkFarID).
-                                WithAction(session.ActionForward).
                                 WithMethod(session.Create).
                                 WithDstInterface(ieLib.DstInterfaceAccess).
-                                WithTEID(uplinkTEID).
-                                WithDownlinkIP(nodeBaddress).
-                                BuildFAR()
+                                WithTrafficSteering(request.TrafficSteering.GetUplinkPolicyID(), request.TrafficSteering.GetDownlinkPolicyID())
+
+                        if farAction == session.ActionDrop {
+                                // the filter is enforced by the FARs discarding the matched traffic
+                                downlinkFARBuilder.WithAction(farAction)
+                        } else if withInitialDownlinkAction {
+                                // the downlink path is provided later on by ModifySession
+                                downlinkFARBuilder.WithAction(toApplyAction(request.InitialDownlinkAction))
+                        } else {
+                                downlinkFARBuilder.
+                                        WithAction(session.ActionForward).
+                                        WithTEID(uplinkTEID).
+                                        WithDownlinkIP(nodeBaddress)
+                        }
+
+                        downlinkFAR := downlinkFARBuilder.BuildFAR()
 
                         fars = append(fars, uplinkFAR)
                         fars = append(fars, downlinkFAR)
 
-                        _ = session.NewQERBuilder().
+                        uplinkAppQER := session.NewQERBuilder().
                                 WithID(uplinkAppQerID).
                                 WithMethod(session.Create).
                                 WithQFI(qfi).
@@ -236,7 +646,7 @@ func (P pfcpSimService) CreateSession(ctx context.Context, request *pb.CreateSes
                                 WithGateStatus(gateStatus).
                                 Build()
 
-                        _ = session.NewQERBuilder().
+                        downlinkAppQER := session.NewQERBuilder().
                                 WithID(downlinkAppQerID).
                                 WithMethod(session.Create).
                                 WithQFI(qfi).
@@ -245,23 +655,122 @@ func (P pfcpSimService) CreateSession(ctx context.Context, request *pb.CreateSes
                                 WithGateStatus(gateStatus).
                                 Build()
 
+                        if flow.gated {
+                                // application QERs enforce the gate status of sub-flows
+                                qers = append(qers, uplinkAppQER, downlinkAppQER)
+                        }
+
                         ID += 2
                 }
 
-                sess, err := sim.EstablishSession(pdrs, fars, qers)
+                if withDefaultRules {
+                        defaultPDRs, defaultFARs := newDefaultRules(ID, uplinkTEID, sessQerID, ueAddress.String(), uint8(ueIPv6PrefixLength), nodeBaddress,
+                                request.UplinkDefaultAction, request.DownlinkDefaultAction, trafficEndpointID, dnn)
+
+                        pdrs = append(pdrs, defaultPDRs...)
+                        fars = append(fars, defaultFARs...)
+                }
+
+                if withExplicitRuleIDs {
+                        if err := checkRuleIDCollisions(i, pdrs, fars, qers); err != nil {
+                                return &pb.Response{}, newBatchError(err, established, i)
+                        }
+                }
+
+                if err := isRuleCountWithinLimits(len(pdrs), len(fars), len(qers)); err != nil {
+                        // all the sessions have the same number of rules: none was established
+                        return &pb.Response{}, err
+                }
+
+                // draining may have started while creating the sessions
+                if err := checkDraining(); err != nil {
+                        return &pb.Response{}, newBatchError(err, established, i)
+                }
+
+                if err := waitOverload(ctx); err != nil {
+                        return &pb.Response{}, newBatchError(err, established, i)
+                }
+
+                if err := waitSessionCreationSlot(ctx); err != nil {
+                        return &pb.Response{}, newBatchError(err, established, i)
+                }
+
+                additionalIEs := append(urrs, trafficEndpoints...)
+
+                if request.InactivityTimerMs != 0 {
+                        additionalIEs = append(additionalIEs,
+                                ieLib.NewUserPlaneInactivityTimer(time.Duration(request.InactivityTimerMs)*time.Millisecond))
+                }
+
+                establishmentStart := time.Now()
+
+                sess, err := sim.EstablishSessionContext(ctx, cpFSEIDAddress, pdrs, fars, qers, additionalIEs...)
                 if err != nil {
-                        return &pb.Response{}, status.Error(codes.Internal, err.Error())
+                        return &pb.Response{}, newBatchError(operationError(ctx, codes.Internal, err), established, i)
+                }
+
+                if latency != nil {
+                        latency.record(time.Since(establishmentStart), time.Now())
+                }
+                logger.WithFields(log.Fields{
+                        "sessionID": i,
+                        "localSEID": sess.LocalSEID(),
+                        "peerSEID":  sess.PeerSEID(),
+                }).Debug("Session established")
+
+                if request.ReportRuleStatus {
+                        ruleStatus = append(ruleStatus, getSessionRuleStatus(i, pdrs, sess.CreatedPDRs()))
+                }
+
+                sessCtx := sessionContext{
+                        transactionID: transactionID,
+                        ruleIDOffset:  ruleIDOffset,
+                        ueAddress:     sessionUEAddress,
+                        uplinkTEID:    uplinkTEID,
+                        pdrs:          pdrs,
+                        fars:          fars,
+                        qers:          qers,
+                }
+
+                if request.Urr != nil {
+                        sessCtx.urrIDs = []uint32{urrID}
+                }
+
+                insertSession(i, sess, sessCtx)
+                established++
+
+                if created != nil {
+                        *created = append(*created, i)
+                }
+
+                if request.HoldTime != nil && request.HoldTime.DurationMs != 0 {
+                        scheduleSessionDeletion(i, sess, getHoldTime(request.HoldTime))
                 }
-                insertSession(i, sess)
         }
 
-        infoMsg := fmt.Sprintf("%v sessions were established using %v as baseID ", count, baseID)
-        log.Info(infoMsg)
+        infoMsg := fmt.Sprintf("%v sessions were established using %v as baseID ", count-skipped, baseID)
 
-        return &pb.Response{
-                StatusCode: int32(codes.OK),
-                Message:    infoMsg,
-        }, nil
+        if skipped != 0 {
+                infoMsg += fmt.Sprintf(", %v sessions with a duplicate UE address were skipped", skipped)
+        }
+        logger.Info(infoMsg)
+
+        if err := waitPostCreateDelay(ctx, time.Duration(request.PostCreateDelayMs)*time.Millisecond); err != nil {
+                return &pb.Response{}, err
+        }
+
+        response := &pb.Response{
+                StatusCode:    int32(codes.OK),
+                Message:       infoMsg,
+                TransactionID: transactionID,
+                RuleStatus:    ruleStatus,
+        }
+
+        if latency != nil {
+                response.Latency = latency.report()
+        }
+
+        return response, nil
 }
 
 func (P pfcpSimService) ModifySession(ctx context.Context, request *pb.ModifySessionRequest) (*pb.Response, error) {
@@ -269,15 +778,21 @@ func (P pfcpSimService) ModifySession(ctx context.Context, request *pb.ModifySes
                 return &pb.Response{}, err
         }
 
+        ctx, cancel, err := withOperationBudget(ctx)
+        if err != nil {
+                return &pb.Response{}, err
+        }
+        defer cancel()
+
         // TODO add 5G mode
         baseID := int(request.BaseID)
         count := int(request.Count)
         nodeBaddress := request.NodeBAddress
 
-        if len(activeSessions) < count {
-                err := pfcpsim.NewNotEnoughSessionsError()
-                log.Error(err)
-                return &pb.Response{}, status.Error(codes.Aborted, err.Error())
+        if missingID, ok := getMissingSessionID(baseID, count); ok && !request.IgnoreMissing {
+                errMsg := fmt.Sprintf("Session with index %v is not active. Check baseID and count", missingID)
+                log.Error(errMsg)
+                return &pb.Response{}, status.Error(codes.NotFound, errMsg)
         }
 
         var actions uint8 = 0
@@ -291,49 +806,134 @@ func (P pfcpSimService) ModifySession(ctx context.Context, request *pb.ModifySes
                 actions |= session.ActionForward
         }
 
-        if err := isNumOfAppFiltersCorrect(request.AppFilters); err != nil {
+        steps, err := toModificationSteps(actions, request.Sequence)
+        if err != nil {
                 return &pb.Response{}, err
         }
 
-        for i := baseID; i < (count*SessionStep + baseID); i = i + SessionStep {
-                var newFARs []*ieLib.IE
+        appFlows := getAppFlows(request.AppFilters)
 
-                ID := uint32(i + 1)
-                teid := uint32(i + 1)
+        if err := isNumOfAppFiltersCorrect(appFlows); err != nil {
+                return &pb.Response{}, err
+        }
 
-                if request.BufferFlag || request.NotifyCPFlag {
-                        teid = 0 // When buffering, TEID = 0.
-                }
+        if request.UrrVolumeThreshold != 0 && request.RemoveURRs {
+                errMsg := "URRs cannot be updated and removed at once"
+                log.Error(errMsg)
+                return &pb.Response{}, status.Error(codes.Aborted, errMsg)
+        }
 
-                for _, _ = range request.AppFilters {
-                        downlinkFAR := session.NewFARBuilder().
-                                WithID(ID). // Same FARID that was generated in create sessions
-                                WithMethod(session.Update).
-                                WithAction(actions).
-                                WithDstInterface(ieLib.DstInterfaceAccess).
-                                WithTEID(teid).
-                                WithDownlinkIP(nodeBaddress).
-                                BuildFAR()
+        // skipped is the number of sessions which were not active, with IgnoreMissing
+        skipped := 0
 
-                        newFARs = append(newFARs, downlinkFAR)
+        for stepIndex, step := range steps {
+                actions := step.actions
+                // modified is the number of sessions modified so far by the step
+                modified := 0
 
-                        ID += 2
+                if step.delay != 0 {
+                        select {
+                        case <-time.After(step.delay):
+                        case <-ctx.Done():
+                                return &pb.Response{}, operationError(ctx, codes.Aborted, ctx.Err())
+                        }
                 }
 
-                sess, ok := getSession(i)
-                if !ok {
-                        errMsg := fmt.Sprintf("Could not retrieve session with index %v", i)
-                        log.Error(errMsg)
-                        return &pb.Response{}, status.Error(codes.Internal, errMsg)
-                }
+                for i := baseID; i < (count*SessionStep + baseID); i = i + SessionStep {
+                        if ctx.Err() != nil {
+                                return &pb.Response{}, newBatchError(operationError(ctx, codes.Aborted, ctx.Err()), modified, i)
+                        }
 
-                err := sim.ModifySession(sess, nil, newFARs, nil)
-                if err != nil {
-                        return &pb.Response{}, status.Error(codes.Internal, err.Error())
+                        sess, sessCtx, ok := getSessionWithContext(i)
+                        if !ok && request.IgnoreMissing {
+                                if stepIndex == 0 {
+                                        log.Warnf("Session with index %v is not active, skipping it", i)
+                                        skipped++
+                                }
+
+                                continue
+                        } else if !ok {
+                                errMsg := fmt.Sprintf("Could not retrieve session with index %v", i)
+                                log.Error(errMsg)
+                                return &pb.Response{}, newBatchError(status.Error(codes.NotFound, errMsg), modified, i)
+                        }
+
+                        logger := log.WithFields(log.Fields{
+                                "transactionID": sessCtx.transactionID,
+                                "sessionID":     i,
+                        })
+
+                        var newFARs []*ieLib.IE
+
+                        // the FARs are updated by ID, as the session was created with (e.g. shifted by an offset, or explicit)
+                        farIDs, err := getAppFlowDownlinkFARIDs(sessCtx.fars, len(appFlows))
+                        if err != nil {
+                                return &pb.Response{}, newBatchError(err, modified, i)
+                        }
+
+                        teid := uint32(i + 1)
+
+                        if actions&(session.ActionBuffer|session.ActionNotify) != 0 {
+                                teid = 0 // When buffering, TEID = 0.
+                        }
+
+                        for _, farID := range farIDs {
+                                downlinkFAR := session.NewFARBuilder().
+                                        WithID(farID). // Same FARID that was generated in create sessions
+                                        WithMethod(session.Update).
+                                        WithAction(actions).
+                                        WithDstInterface(ieLib.DstInterfaceAccess).
+                                        WithTEID(teid).
+                                        WithDownlinkIP(nodeBaddress).
+                                        BuildFAR()
+
+                                newFARs = append(newFARs, downlinkFAR)
+                        }
+
+                        var urrs []*ieLib.IE
+
+                        // URRs are only updated by the first step
+                        if stepIndex == 0 && (request.UrrVolumeThreshold != 0 || request.RemoveURRs) {
+                                if len(sessCtx.urrIDs) == 0 {
+                                        errMsg := fmt.Sprintf("Session with index %v has no URR", i)
+                                        logger.Error(errMsg)
+                                        return &pb.Response{}, newBatchError(status.Error(codes.Aborted, errMsg), modified, i)
+                                }
+
+                                for _, urrID := range sessCtx.urrIDs {
+                                        urrBuilder := session.NewURRBuilder().WithID(urrID)
+
+                                        if request.RemoveURRs {
+                                                urrBuilder.WithMethod(session.Delete)
+                                        } else {
+                                                urrBuilder.WithMethod(session.Update).WithVolumeThreshold(request.UrrVolumeThreshold)
+                                        }
+
+                                        urrs = append(urrs, urrBuilder.Build())
+                                }
+                        }
+
+                        err = sim.ModifySessionContext(ctx, sess, nil, newFARs, nil, urrs...)
+                        if err != nil {
+                                return &pb.Response{}, newBatchError(operationError(ctx, codes.Internal, err), modified, i)
+                        }
+
+                        modified++
+                        logger.Debug("Session modified")
+
+                        if request.RemoveURRs {
+                                setSessionURRIDs(i, nil)
+                        }
                 }
         }
 
-        infoMsg := fmt.Sprintf("%v sessions were modified", count)
+        infoMsg := fmt.Sprintf("%v sessions were modified", count-skipped)
+        if len(steps) > 1 {
+                infoMsg += fmt.Sprintf(" by %v steps", len(steps))
+        }
+        if skipped != 0 {
+                infoMsg += fmt.Sprintf(", %v missing sessions were skipped", skipped)
+        }
         log.Info(infoMsg)
 
         return &pb.Response{
@@ -347,33 +947,345 @@ func (P pfcpSimService) DeleteSession(ctx context.Context, request *pb.DeleteSes
                 return &pb.Response{}, err
         }
 
+        ctx, cancel, err := withOperationBudget(ctx)
+        if err != nil {
+                return &pb.Response{}, err
+        }
+        defer cancel()
+
         baseID := int(request.BaseID)
         count := int(request.Count)
 
-        if len(activeSessions) < count {
+        if getSessionCount() < count {
                 err := pfcpsim.NewNotEnoughSessionsError()
                 log.Error(err)
                 return &pb.Response{}, status.Error(codes.Aborted, err.Error())
         }
 
+        if missingID, ok := getMissingSessionID(baseID, count); ok {
+                errMsg := fmt.Sprintf("Session with index %v is not active. Check baseID and count", missingID)
+                log.Error(errMsg)
+                return &pb.Response{}, status.Error(codes.Aborted, errMsg)
+        }
+
+        // deleted is the number of sessions deleted so far
+        deleted := 0
+
         for i := baseID; i < (count*SessionStep + baseID); i = i + SessionStep {
-                sess, ok := getSession(i)
+                if ctx.Err() != nil {
+                        return &pb.Response{}, newBatchError(operationError(ctx, codes.Aborted, ctx.Err()), deleted, i)
+                }
+
+                sess, sessCtx, ok := getSessionWithContext(i)
                 if !ok {
                         errMsg := "Session was nil. Check baseID"
                         log.Error(errMsg)
-                        return &pb.Response{}, status.Error(codes.Aborted, errMsg)
+                        return &pb.Response{}, newBatchError(status.Error(codes.Aborted, errMsg), deleted, i)
+                }
+
+                logger := log.WithFields(log.Fields{
+                        "transactionID": sessCtx.transactionID,
+                        "sessionID":     i,
+                })
+
+                err := sim.DeleteSessionContext(ctx, sess)
+                if err != nil && validationStrictness == pb.Strictness_LENIENT {
+                        // The UPF rejected the deletion (e.g. session context not found): forget the session anyway
+                        logger.Warnf("Session with index %v was not deleted by the remote peer: %v", i, err)
+                } else if err != nil {
+                        return &pb.Response{}, newBatchError(operationError(ctx, codes.Aborted, err), deleted, i)
                 }
+                // remove from activeSessions, unless deleted meanwhile (e.g. once its hold time elapsed)
+                deleteSessionIfCurrent(i, sess)
+                deleted++
+                logger.Debug("Session deleted")
+        }
+
+        infoMsg := fmt.Sprintf("%v sessions deleted; activeSessions: %v", count, getSessionCount())
+        log.Info(infoMsg)
+
+        return &pb.Response{
+                StatusCode: int32(codes.OK),
+                Message:    infoMsg,
+        }, nil
+}
 
-                err := sim.DeleteSession(sess)
+func (P pfcpSimService) GetSessionCount(ctx context.Context, empty *pb.EmptyRequest) (*pb.SessionCountResponse, error) {
+        associated := isRemotePeerConnected() && sim.IsAssociationAlive()
+
+        return &pb.SessionCountResponse{
+                Count:      int32(getSessionCount()),
+                Associated: associated,
+        }, nil
+}
+
+func (P pfcpSimService) GetSession(ctx context.Context, request *pb.GetSessionRequest) (*pb.SessionResponse, error) {
+        sess, sessCtx, ok := getSessionWithContext(int(request.SessionID))
+        if !ok {
+                errMsg := fmt.Sprintf("Session %v is not active", request.SessionID)
+                log.Error(errMsg)
+                return &pb.SessionResponse{}, status.Error(codes.NotFound, errMsg)
+        }
+
+        response := &pb.SessionResponse{
+                SessionID:  request.SessionID,
+                LocalSEID:  sess.LocalSEID(),
+                PeerSEID:   sess.PeerSEID(),
+                UeAddress:  sessCtx.ueAddress,
+                UplinkTEID: sessCtx.uplinkTEID,
+                UrrIDs:     sessCtx.urrIDs,
+        }
+
+        for _, pdr := range sessCtx.pdrs {
+                info, err := toPDRInfo(pdr)
                 if err != nil {
-                        log.Error(err.Error())
-                        return &pb.Response{}, status.Error(codes.Aborted, err.Error())
+                        log.Error(err)
+                        return &pb.SessionResponse{}, status.Error(codes.Internal, err.Error())
                 }
-                // remove from activeSessions
-                deleteSession(i)
+
+                response.Pdrs = append(response.Pdrs, info)
+        }
+
+        for _, far := range sessCtx.fars {
+                info, err := toFARInfo(far)
+                if err != nil {
+                        log.Error(err)
+                        return &pb.SessionResponse{}, status.Error(codes.Internal, err.Error())
+                }
+
+                response.Fars = append(response.Fars, info)
+        }
+
+        for _, qer := range sessCtx.qers {
+                info, err := toQERInfo(qer)
+                if err != nil {
+                        log.Error(err)
+                        return &pb.SessionResponse{}, status.Error(codes.Internal, err.Error())
+                }
+
+                response.Qers = append(response.Qers, info)
+        }
+
+        return response, nil
+}
+
+func (P pfcpSimService) GetSessionMapping(ctx context.Context, empty *pb.EmptyRequest) (*pb.SessionMappingResponse, error) {
+        return &pb.SessionMappingResponse{
+                Sessions: getSessionMappings(),
+        }, nil
+}
+
+func (P pfcpSimService) Setup(ctx context.Context, request *pb.SetupRequest) (*pb.Response, error) {
+        if request.Sessions == nil {
+                errMsg := "Setup requires the sessions to create"
+                log.Error(errMsg)
+                return &pb.Response{}, status.Error(codes.Aborted, errMsg)
+        }
+
+        if request.Configure != nil {
+                if _, err := P.Configure(ctx, request.Configure); err != nil {
+                        return &pb.Response{}, err
+                }
+        }
+
+        retryDelay := time.Duration(request.RetryDelayMs) * time.Millisecond
+
+        var err error
+
+        for attempt := 1; attempt <= int(request.Retries)+1; attempt++ {
+                if attempt > 1 {
+                        select {
+                        case <-time.After(retryDelay):
+                        case <-ctx.Done():
+                                return &pb.Response{}, operationError(ctx, codes.Aborted, ctx.Err())
+                        }
+                }
+
+                var res *pb.Response
+
+                res, err = P.setupOnce(ctx, request.Sessions)
+                if err == nil {
+                        infoMsg := fmt.Sprintf("Setup completed after %v attempts: %v", attempt, res.Message)
+                        log.Info(infoMsg)
+
+                        return &pb.Response{
+                                StatusCode:    int32(codes.OK),
+                                Message:       infoMsg,
+                                TransactionID: res.TransactionID,
+                        }, nil
+                }
+
+                log.Warnf("Setup attempt %v failed and was rolled back: %v", attempt, err)
+        }
+
+        return &pb.Response{}, err
+}
+
+// setupOnce associates, if not associated yet, and creates the sessions. If it fails, the sessions created
+// and the association established are rolled back.
+func (P pfcpSimService) setupOnce(ctx context.Context, request *pb.CreateSessionRequest) (*pb.Response, error) {
+        associated := false
+
+        if !isRemotePeerConnected() {
+                if _, err := P.Associate(ctx, &pb.EmptyRequest{}); err != nil {
+                        return &pb.Response{}, err
+                }
+
+                associated = true
+        }
+
+        var created []int
+
+        res, err := P.createSessions(ctx, request, &created)
+        if err != nil {
+                // the rollback is not bound to ctx, which may be done already
+                P.rollbackSetup(context.Background(), created, associated)
+                return &pb.Response{}, err
+        }
+
+        return res, nil
+}
+
+// rollbackSetup deletes the sessions with the given IDs, created by the failed setup,
+// then tears down the association if it was established by the setup.
+func (P pfcpSimService) rollbackSetup(ctx context.Context, created []int, associated bool) {
+        for _, id := range created {
+                if _, ok := getSession(id); !ok {
+                        // already deleted, e.g. once its hold time elapsed
+                        continue
+                }
+
+                if _, err := P.DeleteSession(ctx, &pb.DeleteSessionRequest{
+                        Count:  1,
+                        BaseID: int32(id),
+                }); err != nil {
+                        log.Errorf("Could not delete session %v created by the failed setup: %v", id, err)
+                }
+        }
+
+        if associated {
+                if _, err := P.Disassociate(ctx, &pb.EmptyRequest{}); err != nil {
+                        log.Errorf("Could not tear down the association established by the failed setup: %v", err)
+                }
+        }
+}
+
+func (P pfcpSimService) SelfTest(ctx context.Context, request *pb.SelfTestRequest) (*pb.SelfTestResponse, error) {
+        if err := checkServerStatus(); err != nil {
+                return &pb.SelfTestResponse{}, err
+        }
+
+        baseID := request.BaseID
+        if baseID == 0 {
+                baseID = int32(getFreeSessionID())
+        }
+
+        if _, ok := getSession(int(baseID)); ok {
+                errMsg := fmt.Sprintf("Session with index %v already exists", baseID)
+                log.Error(errMsg)
+                return &pb.SelfTestResponse{}, status.Error(codes.Aborted, errMsg)
+        }
+
+        nodeBaddress := request.NodeBAddress
+        if nodeBaddress == "" {
+                nodeBaddress = selfTestNodeBAddress
+        }
+
+        ueAddressPool := request.UeAddressPool
+        if ueAddressPool == "" {
+                ueAddressPool = selfTestUEAddressPool
+        }
+
+        response := &pb.SelfTestResponse{}
+
+        created := runSelfTestStep(response, "create", func() error {
+                _, err := P.CreateSession(ctx, &pb.CreateSessionRequest{
+                        Count:         1,
+                        BaseID:        baseID,
+                        NodeBAddress:  nodeBaddress,
+                        UeAddressPool: ueAddressPool,
+                        AppFilters:    []string{selfTestAppFilter},
+                })
+                return err
+        })
+
+        if created {
+                runSelfTestStep(response, "modify", func() error {
+                        _, err := P.ModifySession(ctx, &pb.ModifySessionRequest{
+                                Count:        1,
+                                BaseID:       baseID,
+                                NodeBAddress: nodeBaddress,
+                                BufferFlag:   true,
+                                NotifyCPFlag: true,
+                                AppFilters:   []string{selfTestAppFilter},
+                        })
+                        return err
+                })
+
+                // the session is deleted regardless of the outcome of the previous steps
+                runSelfTestStep(response, "delete", func() error {
+                        _, err := P.DeleteSession(ctx, &pb.DeleteSessionRequest{
+                                Count:  1,
+                                BaseID: baseID,
+                        })
+                        return err
+                })
+        }
+
+        response.Passed = true
+
+        for _, step := range response.Steps {
+                response.Passed = response.Passed && step.Passed
+        }
+
+        log.Infof("Self-test passed: %v", response.Passed)
+
+        return response, nil
+}
+
+func (P pfcpSimService) CheckDataPath(ctx context.Context, request *pb.CheckDataPathRequest) (*pb.CheckDataPathResponse, error) {
+        n3Address := request.N3Address
+        if n3Address == "" {
+                n3Address = upfN3Address
+        }
+
+        if n3Address == "" {
+                errMsg := "N3 address is not configured"
+                log.Error(errMsg)
+                return &pb.CheckDataPathResponse{}, status.Error(codes.Aborted, errMsg)
+        }
+
+        timeout := defaultDataPathCheckTimeout
+        if request.TimeoutMs != 0 {
+                timeout = time.Duration(request.TimeoutMs) * time.Millisecond
+        }
+
+        rtt, err := pfcpsim.SendGTPUEcho(n3Address, timeout)
+        if err != nil {
+                log.Warnf("Data path towards %v is down: %v", n3Address, err)
+
+                return &pb.CheckDataPathResponse{
+                        Reachable: false,
+                        Error:     err.Error(),
+                }, nil
+        }
+
+        log.Infof("Data path towards %v is up. Round-trip time: %v", n3Address, rtt)
+
+        return &pb.CheckDataPathResponse{
+                Reachable:       true,
+                RoundTripTimeUs: uint64(rtt.Microseconds()),
+        }, nil
+}
+
+func (P pfcpSimService) SetDrain(ctx context.Context, request *pb.SetDrainRequest) (*pb.Response, error) {
+        draining = request.Drain
+
+        infoMsg := "Server is not draining anymore"
+        if draining {
+                infoMsg = "Server is draining: new sessions are rejected, existing sessions can still be modified and deleted"
         }
 
-        infoMsg := fmt.Sprintf("%v sessions deleted; activeSessions: %v", count, len(activeSessions))
         log.Info(infoMsg)
 
         return &pb.Response{
@@ -381,3 +1293,32 @@ func (P pfcpSimService) DeleteSession(ctx context.Context, request *pb.DeleteSes
                 Message:    infoMsg,
         }, nil
 }
+
+func (P pfcpSimService) GetUPFLoad(ctx context.Context, empty *pb.EmptyRequest) (*pb.UPFLoadResponse, error) {
+        response := &pb.UPFLoadResponse{}
+
+        if sim == nil {
+                return response, nil
+        }
+
+        if load := sim.LoadControl(); !load.ReceivedAt.IsZero() {
+                response.Load = &pb.LoadControlInfo{
+                        SequenceNumber: load.SequenceNumber,
+                        Metric:         uint32(load.Metric),
+                }
+        }
+
+        if overload := sim.OverloadControl(); !overload.ReceivedAt.IsZero() {
+                response.Overload = &pb.OverloadControlInfo{
+                        SequenceNumber:  overload.SequenceNumber,
+                        ReductionMetric: uint32(overload.ReductionMetric),
+                        Active:          overload.IsActive(),
+                }
+
+                if remaining := time.Until(overload.ExpiresAt()); remaining > 0 {
+                        response.Overload.RemainingValidityMs = uint64(remaining.Milliseconds())
+                }
+        }
+
+        return response, nil
+}
@@ -0,0 +1,2443 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"context"
+	"math"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/infinitydon/pfcpsim/api"
+	"github.com/infinitydon/pfcpsim/pkg/pfcpsim"
+	"github.com/infinitydon/pfcpsim/pkg/pfcpsim/session"
+	log "github.com/sirupsen/logrus"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	ieLib "github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// resetState restores the server state to its defaults.
+func resetState() {
+	if sim != nil && remotePeerConnected {
+		sim.DisconnectN4()
+	}
+
+	sim = nil
+	remotePeerConnected = false
+	remotePeerAddress = ""
+	upfN3Address = ""
+	activeSessions = make(map[int]*pfcpsim.PFCPSession)
+	sessionContexts = make(map[int]sessionContext)
+
+	for _, timer := range sessionHoldTimers {
+		timer.Stop()
+	}
+
+	sessionHoldTimers = make(map[int]holdTimer)
+	startHoldTimer = func(d time.Duration, f func()) holdTimer { return time.AfterFunc(d, f) }
+	assumeAssociated = false
+	autoAssociate = false
+	localAddress = ""
+	validationStrictness = pb.Strictness_NORMAL
+	socketOptions = pfcpsim.SocketOptions{}
+	associationCooldown = 0
+	lastDisassociation = time.Time{}
+	throttleOnOverload = false
+	honorOverloadReduction = false
+	ieFilter = pfcpsim.IEFilter{}
+	nodeType = pfcpsim.NodeTypeUnspecified
+	responseTimeout = pfcpsim.DefaultResponseTimeout
+	retransmissions = 0
+	timeoutBackoff = 1
+	maxPDRsPerSession = 0
+	maxFARsPerSession = 0
+	maxQERsPerSession = 0
+	minTEID = 0
+	maxTEID = 0
+	recoveryTimeStamp = time.Time{}
+	draining = false
+	defaultDNN = ""
+	logSequenceWraparound = false
+	SetMaxSessionCreationRate(0)
+}
+
+// newAssumeAssociatedService returns a service running in assume-associated mode.
+// The server state is reset once the test completes.
+func newAssumeAssociatedService(t *testing.T) *pfcpSimService {
+	resetState()
+	t.Cleanup(resetState)
+
+	SetAssumeAssociatedMode(true)
+
+	return NewPFCPSimService("")
+}
+
+// sentMessageTypes returns the types of the messages sent to the emulated peer, skipping heartbeats.
+func sentMessageTypes() []uint8 {
+	var types []uint8
+
+	for _, msg := range sim.SentMessages() {
+		if msg.MessageType() != message.MsgTypeHeartbeatRequest {
+			types = append(types, msg.MessageType())
+		}
+	}
+
+	return types
+}
+
+func TestAssumeAssociatedMode(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	ctx := context.Background()
+
+	_, err := service.CreateSession(ctx, &pb.CreateSessionRequest{
+		Count:         2,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"ip:any:any:allow:100"},
+	})
+	require.NoError(t, err)
+
+	_, err = service.ModifySession(ctx, &pb.ModifySessionRequest{
+		Count:        2,
+		BaseID:       1,
+		NodeBAddress: "10.0.0.1",
+		AppFilters:   []string{"ip:any:any:allow:100"},
+	})
+	require.NoError(t, err)
+
+	_, err = service.DeleteSession(ctx, &pb.DeleteSessionRequest{
+		Count:  2,
+		BaseID: 1,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []uint8{
+		message.MsgTypeAssociationSetupRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+		message.MsgTypeSessionModificationRequest,
+		message.MsgTypeSessionModificationRequest,
+		message.MsgTypeSessionDeletionRequest,
+		message.MsgTypeSessionDeletionRequest,
+	}, sentMessageTypes())
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+	require.Len(t, estReq.CreatePDR, 2)
+	require.Len(t, estReq.CreateFAR, 2)
+
+	res, err := service.GetSessionCount(ctx, &pb.EmptyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), res.Count)
+}
+
+func TestCreateSessionWithFiveQI(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"ip:any:any:allow:100"},
+		FiveQI:        1,
+		DownlinkMBR:   256,
+	})
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+	require.Len(t, estReq.CreateQER, 1)
+
+	qfi, err := estReq.CreateQER[0].QFI()
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), qfi)
+
+	ulMBR, err := estReq.CreateQER[0].MBRUL()
+	require.NoError(t, err)
+	require.Equal(t, uint64(128), ulMBR)
+
+	dlMBR, err := estReq.CreateQER[0].MBRDL()
+	require.NoError(t, err)
+	require.Equal(t, uint64(256), dlMBR)
+
+	ulGBR, err := estReq.CreateQER[0].GBRUL()
+	require.NoError(t, err)
+	require.Equal(t, uint64(128), ulGBR)
+
+	_, err = service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        11,
+		UeAddressPool: "17.0.0.0/24",
+		FiveQI:        10,
+	})
+	require.Error(t, err)
+}
+
+func TestCreateSessionWithIPv6Prefix(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:              2,
+		BaseID:             1,
+		NodeBAddress:       "10.0.0.1",
+		UeAddressPool:      "2001:db8:1::/48",
+		AppFilters:         []string{"ip:any:any:allow:100"},
+		UeIPv6PrefixLength: 64,
+	})
+	require.NoError(t, err)
+
+	for i, expectedPrefix := range []string{"2001:db8:1::", "2001:db8:1:1::"} {
+		estReq := sim.SentMessages()[i+1].(*message.SessionEstablishmentRequest)
+
+		// the downlink PDR follows the uplink one
+		ueIPAddress, err := estReq.CreatePDR[1].UEIPAddress()
+		require.NoError(t, err)
+		require.True(t, ueIPAddress.IPv6Address.Equal(net.ParseIP(expectedPrefix)))
+		require.Equal(t, uint8(64), ueIPAddress.IPv6PrefixLength)
+		require.Nil(t, ueIPAddress.IPv4Address)
+	}
+
+	// prefixes must be longer than the pool one
+	_, err = service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:              1,
+		BaseID:             21,
+		UeAddressPool:      "2001:db8:1::/48",
+		UeIPv6PrefixLength: 48,
+	})
+	require.Error(t, err)
+
+	_, err = service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:              1,
+		BaseID:             21,
+		UeAddressPool:      "17.0.0.0/24",
+		UeIPv6PrefixLength: 64,
+	})
+	require.Error(t, err)
+}
+
+func TestOperationBudgetMetadata(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	require.NoError(t, setupLoopbackAssociation())
+
+	// the emulated peer never answers session establishments
+	sim.ConnectLoopback(func(req message.Message) message.Message {
+		if req.MessageType() == message.MsgTypeSessionEstablishmentRequest {
+			return nil
+		}
+
+		return pfcpsim.AcceptAllResponder(req)
+	})
+
+	request := &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"ip:any:any:allow:100"},
+	}
+
+	budget := 200 * time.Millisecond
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(operationBudgetMetadataKey, budget.String()))
+
+	start := time.Now()
+	_, err := service.CreateSession(ctx, request)
+	elapsed := time.Since(start)
+
+	require.Error(t, err)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	require.GreaterOrEqual(t, elapsed, budget)
+	require.Less(t, elapsed, pfcpsim.DefaultResponseTimeout/2)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(operationBudgetMetadataKey, "three seconds"))
+
+	_, err = service.CreateSession(ctx, request)
+	require.Error(t, err)
+	require.Equal(t, codes.Aborted, status.Code(err))
+}
+
+func TestCreateSessionPartialBatchFailure(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	require.NoError(t, setupLoopbackAssociation())
+
+	// the emulated peer rejects the 4th session establishment
+	var establishments int
+
+	sim.ConnectLoopback(func(req message.Message) message.Message {
+		if req.MessageType() == message.MsgTypeSessionEstablishmentRequest {
+			establishments++
+
+			if establishments == 4 {
+				return message.NewSessionEstablishmentResponse(0, 0, 0, req.Sequence(), 0,
+					ieLib.NewNodeID(pfcpsim.LoopbackAddress, "", ""), ieLib.NewCause(ieLib.CauseNoResourcesAvailable))
+			}
+		}
+
+		return pfcpsim.AcceptAllResponder(req)
+	})
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         6,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"ip:any:any:allow:100"},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "3 sessions succeeded; session 4 (ID 31) failed")
+
+	st := status.Convert(err)
+	require.Len(t, st.Details(), 1)
+
+	failure, ok := st.Details()[0].(*pb.BatchFailure)
+	require.True(t, ok)
+	require.Equal(t, int32(3), failure.Succeeded)
+	require.Equal(t, int32(4), failure.FailedIndex)
+	require.Equal(t, int32(31), failure.FailedSessionID)
+	require.NotEmpty(t, failure.Cause)
+
+	res, err := service.GetSessionCount(context.Background(), &pb.EmptyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), res.Count)
+}
+
+func TestModifySessionBatchFailureSkipsMissing(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         3,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	})
+	require.NoError(t, err)
+
+	_, err = service.DeleteSession(context.Background(), &pb.DeleteSessionRequest{Count: 1, BaseID: 11})
+	require.NoError(t, err)
+
+	// the emulated peer rejects the 2nd session modification, i.e. the one of the last session
+	var modifications int
+
+	sim.ConnectLoopback(func(req message.Message) message.Message {
+		if req.MessageType() == message.MsgTypeSessionModificationRequest {
+			modifications++
+
+			if modifications == 2 {
+				return message.NewSessionModificationResponse(0, 0, 0, req.Sequence(), 0,
+					ieLib.NewCause(ieLib.CauseNoResourcesAvailable))
+			}
+		}
+
+		return pfcpsim.AcceptAllResponder(req)
+	})
+
+	_, err = service.ModifySession(context.Background(), &pb.ModifySessionRequest{
+		Count:         3,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		IgnoreMissing: true,
+	})
+	require.Error(t, err)
+
+	// the missing session is not counted as succeeded
+	failure, ok := status.Convert(err).Details()[0].(*pb.BatchFailure)
+	require.True(t, ok)
+	require.Equal(t, int32(1), failure.Succeeded)
+	require.Equal(t, int32(2), failure.FailedIndex)
+	require.Equal(t, int32(21), failure.FailedSessionID)
+}
+
+func TestCreateSessionWithSessionQERID(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:                 1,
+		BaseID:                1,
+		NodeBAddress:          "10.0.0.1",
+		UeAddressPool:         "17.0.0.0/24",
+		AppFilters:            []string{"udp:any:80-80:allow:100", "ip:any:any:deny:200"},
+		UplinkDefaultAction:   pb.FARAction_DROP,
+		DownlinkDefaultAction: pb.FARAction_DROP,
+		SessionQerID:          99,
+	})
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+	require.Len(t, estReq.CreatePDR, 6)
+
+	for _, pdr := range estReq.CreatePDR {
+		qerID, err := pdr.QERID()
+		require.NoError(t, err)
+		require.Equal(t, uint32(99), qerID)
+	}
+
+	var qersWithID []uint32
+
+	for _, qer := range estReq.CreateQER {
+		qerID, err := qer.QERID()
+		require.NoError(t, err)
+
+		if qerID == 99 {
+			qersWithID = append(qersWithID, qerID)
+		}
+	}
+
+	require.Len(t, qersWithID, 1)
+
+	// the application QERs of the session with index 91 use IDs from 91 to 100
+	_, err = service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        91,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"ip:any:any:allow:100", "ip:any:any:allow:100", "ip:any:any:allow:100", "ip:any:any:allow:100", "ip:any:any:allow:100"},
+		SessionQerID:  99,
+	})
+	require.Error(t, err)
+}
+
+func TestAssociationCooldown(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	ctx := context.Background()
+
+	cooldown := 200 * time.Millisecond
+
+	_, err := service.Configure(ctx, &pb.ConfigureRequest{
+		UpfN3Address:          pfcpsim.LoopbackAddress,
+		RemotePeerAddress:     pfcpsim.LoopbackAddress,
+		AssociationCooldownMs: uint32(cooldown.Milliseconds()),
+	})
+	require.NoError(t, err)
+
+	// no disassociation yet: no cooldown
+	start := time.Now()
+	_, err = service.Associate(ctx, &pb.EmptyRequest{})
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), cooldown)
+
+	start = time.Now()
+	_, err = service.ReAssociate(ctx, &pb.EmptyRequest{})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), cooldown)
+
+	// messages are recorded from the last connection to the emulated peer
+	require.Equal(t, []uint8{message.MsgTypeAssociationSetupRequest}, sentMessageTypes())
+	require.True(t, sim.IsAssociationAlive())
+
+	_, err = service.Disassociate(ctx, &pb.EmptyRequest{})
+	require.NoError(t, err)
+
+	start = time.Now()
+	_, err = service.Associate(ctx, &pb.EmptyRequest{})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), cooldown)
+}
+
+func TestCreateSessionWithPredefinedRules(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:           1,
+		BaseID:          1,
+		NodeBAddress:    "10.0.0.1",
+		UeAddressPool:   "17.0.0.0/24",
+		AppFilters:      []string{"ip:any:any:allow:100"},
+		PredefinedRules: []string{"video-optimization"},
+	})
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+	require.Len(t, estReq.CreatePDR, 2)
+
+	for _, pdr := range estReq.CreatePDR {
+		name, err := pdr.ActivatePredefinedRules()
+		require.NoError(t, err)
+		require.Equal(t, "video-optimization", name)
+	}
+}
+
+func TestCreateSessionWithBitRateUnit(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"ip:any:any:allow:100"},
+		UplinkMBR:     1,
+		DownlinkMBR:   2,
+		BitRateUnit:   pb.BitRateUnit_MBPS,
+	})
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+
+	// MBR IEs are encoded in kbps
+	ulMBR, err := estReq.CreateQER[0].MBRUL()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), ulMBR)
+
+	dlMBR, err := estReq.CreateQER[0].MBRDL()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2000), dlMBR)
+
+	_, err = service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        11,
+		UeAddressPool: "17.0.0.0/24",
+		UplinkMBR:     1 << 40,
+		BitRateUnit:   pb.BitRateUnit_MBPS,
+	})
+	require.Error(t, err)
+}
+
+func TestCreateSessionWithRuleIDOffset(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:                 1,
+		BaseID:                1,
+		NodeBAddress:          "10.0.0.1",
+		UeAddressPool:         "17.0.0.0/24",
+		AppFilters:            []string{"udp:any:80-80:allow:100", "ip:any:any:deny:200"},
+		UplinkDefaultAction:   pb.FARAction_DROP,
+		DownlinkDefaultAction: pb.FARAction_DROP,
+		Urr:                   &pb.URRSpec{Volume: true},
+		RuleIDOffset:          1000,
+	})
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+
+	var pdrIDs []uint16
+
+	for _, pdr := range estReq.CreatePDR {
+		pdrID, err := pdr.PDRID()
+		require.NoError(t, err)
+		pdrIDs = append(pdrIDs, pdrID)
+
+		qerID, err := pdr.QERID()
+		require.NoError(t, err)
+		require.Equal(t, uint32(1000), qerID)
+	}
+
+	require.Equal(t, []uint16{1001, 1002, 1003, 1004, 1005, 1006}, pdrIDs)
+
+	var farIDs []uint32
+
+	for _, far := range estReq.CreateFAR {
+		farID, err := far.FARID()
+		require.NoError(t, err)
+		farIDs = append(farIDs, farID)
+	}
+
+	require.Equal(t, []uint32{1001, 1002, 1003, 1004, 1005, 1006}, farIDs)
+
+	require.Len(t, estReq.CreateQER, 1)
+	qerID, err := estReq.CreateQER[0].QERID()
+	require.NoError(t, err)
+	require.Equal(t, uint32(1000), qerID)
+
+	require.Len(t, estReq.CreateURR, 1)
+	urrID, err := estReq.CreateURR[0].URRID()
+	require.NoError(t, err)
+	require.Equal(t, uint32(1001), urrID)
+
+	// modifications use the offset the session was created with
+	_, err = service.ModifySession(context.Background(), &pb.ModifySessionRequest{
+		Count:        1,
+		BaseID:       1,
+		NodeBAddress: "10.0.0.1",
+		AppFilters:   []string{"udp:any:80-80:allow:100", "ip:any:any:deny:200"},
+	})
+	require.NoError(t, err)
+
+	modReq := sim.SentMessages()[2].(*message.SessionModificationRequest)
+
+	farIDs = nil
+
+	for _, far := range modReq.UpdateFAR {
+		farID, err := far.FARID()
+		require.NoError(t, err)
+		farIDs = append(farIDs, farID)
+	}
+
+	require.Equal(t, []uint32{1002, 1004}, farIDs)
+
+	_, err = service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        11,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		RuleIDOffset:  65530,
+	})
+	require.Error(t, err)
+}
+
+// fakeHoldTimer is a hold timer whose expiry is triggered by the test.
+type fakeHoldTimer struct {
+	duration time.Duration
+	expire   func()
+	stopped  bool
+}
+
+func (t *fakeHoldTimer) Stop() bool {
+	wasPending := !t.stopped
+	t.stopped = true
+
+	return wasPending
+}
+
+// useFakeHoldTimers makes the sessions created afterwards use fake hold timers. Returns the started timers.
+func useFakeHoldTimers() *[]*fakeHoldTimer {
+	var timers []*fakeHoldTimer
+
+	startHoldTimer = func(d time.Duration, f func()) holdTimer {
+		timer := &fakeHoldTimer{duration: d, expire: f}
+		timers = append(timers, timer)
+
+		return timer
+	}
+
+	return &timers
+}
+
+func TestCreateSessionWithHoldTime(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	started := useFakeHoldTimers()
+
+	holdTime := 300 * time.Millisecond
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         3,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		HoldTime: &pb.HoldTime{
+			DurationMs:   uint32(holdTime.Milliseconds()),
+			Distribution: pb.HoldTimeDistribution_FIXED,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, getSessionCount())
+
+	timers := *started
+	require.Len(t, timers, 3)
+
+	// the sessions are deleted while another RPC uses the PFCP client
+	var wg sync.WaitGroup
+
+	for _, timer := range timers {
+		require.Equal(t, holdTime, timer.duration)
+
+		wg.Add(1)
+
+		go func(expire func()) {
+			defer wg.Done()
+			expire()
+		}(timer.expire)
+	}
+
+	_, err = service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        100,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	})
+	require.NoError(t, err)
+
+	wg.Wait()
+
+	require.Equal(t, 1, getSessionCount())
+
+	var deletions int
+
+	for _, msgType := range sentMessageTypes() {
+		if msgType == message.MsgTypeSessionDeletionRequest {
+			deletions++
+		}
+	}
+
+	require.Equal(t, 3, deletions)
+}
+
+func TestHoldTimeExpiryDuringDeleteSession(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	started := useFakeHoldTimers()
+
+	request := &pb.CreateSessionRequest{
+		Count:         3,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		HoldTime:      &pb.HoldTime{DurationMs: 1000},
+	}
+
+	_, err := service.CreateSession(context.Background(), request)
+	require.NoError(t, err)
+
+	request.BaseID = 100
+	request.UeAddressPool = "18.0.0.0/24"
+	request.HoldTime = nil
+
+	_, err = service.CreateSession(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, 6, getSessionCount())
+
+	// the sessions with a hold time are deleted while DeleteSession deletes the other ones
+	var wg sync.WaitGroup
+
+	for _, timer := range *started {
+		wg.Add(1)
+
+		go func(expire func()) {
+			defer wg.Done()
+			expire()
+		}(timer.expire)
+	}
+
+	_, err = service.DeleteSession(context.Background(), &pb.DeleteSessionRequest{Count: 3, BaseID: 100})
+	require.NoError(t, err)
+
+	wg.Wait()
+
+	require.Equal(t, 0, getSessionCount())
+}
+
+func TestHoldTimeExpiryKeepsReusedIndex(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	started := useFakeHoldTimers()
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		HoldTime:      &pb.HoldTime{DurationMs: 1000},
+	})
+	require.NoError(t, err)
+
+	// while the expired session is being deleted, it is deleted by a RPC and another session is created at its index
+	replacement := &pfcpsim.PFCPSession{}
+
+	sim.ConnectLoopback(func(req message.Message) message.Message {
+		if req.MessageType() == message.MsgTypeSessionDeletionRequest {
+			deleteSession(1)
+			insertSession(1, replacement, sessionContext{})
+		}
+
+		return pfcpsim.AcceptAllResponder(req)
+	})
+
+	(*started)[0].expire()
+
+	current, ok := getSession(1)
+	require.True(t, ok)
+	require.Same(t, replacement, current)
+}
+func TestThrottleOnOverload(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	// the emulated peer signals an overload in Session Establishment Responses
+	sim = pfcpsim.NewPFCPClient(pfcpsim.LoopbackAddress)
+	sim.ConnectLoopback(func(req message.Message) message.Message {
+		resp := pfcpsim.AcceptAllResponder(req)
+
+		if estResp, ok := resp.(*message.SessionEstablishmentResponse); ok {
+			estResp.LoadControlInformation = ieLib.NewLoadControlInformation(
+				ieLib.NewSequenceNumber(1),
+				ieLib.NewMetric(90),
+			)
+			estResp.OverloadControlInformation = ieLib.NewOverloadControlInformation(
+				ieLib.NewSequenceNumber(1),
+				ieLib.NewMetric(50),
+				ieLib.NewTimer(time.Minute),
+			)
+		}
+
+		return resp
+	})
+	remotePeerConnected = true
+	require.NoError(t, sim.SetupAssociation())
+
+	throttleOnOverload = true
+
+	request := &pb.CreateSessionRequest{
+		Count:         2,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(operationBudgetMetadataKey, "200ms"))
+
+	// the first session is created, then creation waits for the overload to expire
+	_, err := service.CreateSession(ctx, request)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	require.Equal(t, 1, getSessionCount())
+
+	load, err := service.GetUPFLoad(context.Background(), &pb.EmptyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(90), load.Load.Metric)
+	require.Equal(t, uint32(50), load.Overload.ReductionMetric)
+	require.True(t, load.Overload.Active)
+	require.Greater(t, load.Overload.RemainingValidityMs, uint64(0))
+
+	// without throttling, the overload is ignored
+	throttleOnOverload = false
+	request.BaseID = 11
+
+	_, err = service.CreateSession(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, 3, getSessionCount())
+}
+
+func TestCreateSessionWithGatedSubFlows(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"udp:any:80-80:allow:100,udp:any:81-81:deny:100"},
+	})
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+	require.Len(t, estReq.CreatePDR, 4)
+
+	// the session QER, then the uplink and downlink application QERs of each sub-flow
+	require.Len(t, estReq.CreateQER, 5)
+
+	gateStatuses := make(map[uint32]uint8)
+
+	for _, qer := range estReq.CreateQER[1:] {
+		qerID, err := qer.QERID()
+		require.NoError(t, err)
+
+		gate, err := qer.GateStatus()
+		require.NoError(t, err)
+
+		gateStatuses[qerID] = gate
+	}
+
+	open := ieLib.GateStatusOpen<<2 | ieLib.GateStatusOpen
+	closed := ieLib.GateStatusClosed<<2 | ieLib.GateStatusClosed
+
+	require.Equal(t, map[uint32]uint8{1: open, 2: open, 3: closed, 4: closed}, gateStatuses)
+
+	// PDRs reference both the session QER and the application QER of their sub-flow
+	for i, pdr := range estReq.CreatePDR {
+		var qerIDs []uint32
+
+		for _, child := range pdr.ChildIEs {
+			if child.Type == ieLib.QERID {
+				qerID, err := child.QERID()
+				require.NoError(t, err)
+				qerIDs = append(qerIDs, qerID)
+			}
+		}
+
+		require.Equal(t, []uint32{0, uint32(i + 1)}, qerIDs)
+	}
+}
+
+func TestSelfTest(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	res, err := service.SelfTest(context.Background(), &pb.SelfTestRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Passed)
+
+	var steps []string
+
+	for _, step := range res.Steps {
+		require.True(t, step.Passed, step.Error)
+		steps = append(steps, step.Name)
+	}
+
+	require.Equal(t, []string{"create", "modify", "delete"}, steps)
+	require.Equal(t, 0, getSessionCount())
+
+	require.Equal(t, []uint8{
+		message.MsgTypeAssociationSetupRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+		message.MsgTypeSessionModificationRequest,
+		message.MsgTypeSessionDeletionRequest,
+	}, sentMessageTypes())
+
+	// the test session is deleted even if its modification fails
+	sim.ConnectLoopback(func(req message.Message) message.Message {
+		if _, ok := req.(*message.SessionModificationRequest); ok {
+			return message.NewSessionModificationResponse(0, 0, req.SEID(), req.Sequence(), 0,
+				ieLib.NewCause(ieLib.CauseRequestRejected))
+		}
+
+		return pfcpsim.AcceptAllResponder(req)
+	})
+
+	res, err = service.SelfTest(context.Background(), &pb.SelfTestRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Passed)
+	require.Len(t, res.Steps, 3)
+	require.False(t, res.Steps[1].Passed)
+	require.True(t, res.Steps[2].Passed)
+	require.Equal(t, 0, getSessionCount())
+}
+
+func TestConfigureIEFilter(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.Configure(context.Background(), &pb.ConfigureRequest{
+		UpfN3Address:    "10.0.0.1",
+		ExcludedIETypes: []uint32{uint32(ieLib.Precedence)},
+	})
+	require.NoError(t, err)
+
+	_, err = service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"udp:any:80-80:allow:100"},
+	})
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+	require.Len(t, estReq.CreatePDR, 2)
+
+	for _, pdr := range estReq.CreatePDR {
+		_, err := pdr.Precedence()
+		require.ErrorIs(t, err, ieLib.ErrIENotFound)
+
+		// other IEs are kept
+		_, err = pdr.PDRID()
+		require.NoError(t, err)
+
+		_, err = pdr.SDFFilter()
+		require.NoError(t, err)
+	}
+
+	_, err = service.Configure(context.Background(), &pb.ConfigureRequest{
+		UpfN3Address:    "10.0.0.1",
+		ExcludedIETypes: []uint32{math.MaxUint16 + 1},
+	})
+	require.Error(t, err)
+}
+
+func TestModifySessionURRs(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"udp:any:80-80:allow:100"},
+		Urr:           &pb.URRSpec{Volume: true},
+	})
+	require.NoError(t, err)
+
+	_, err = service.ModifySession(context.Background(), &pb.ModifySessionRequest{
+		Count:              1,
+		BaseID:             1,
+		NodeBAddress:       "10.0.0.1",
+		UrrVolumeThreshold: 1000000,
+	})
+	require.NoError(t, err)
+
+	modReq := sim.SentMessages()[2].(*message.SessionModificationRequest)
+	require.Len(t, modReq.UpdateURR, 1)
+
+	urrID, err := modReq.UpdateURR[0].URRID()
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), urrID)
+
+	threshold, err := modReq.UpdateURR[0].VolumeThreshold()
+	require.NoError(t, err)
+	require.True(t, threshold.HasTOVOL())
+	require.Equal(t, uint64(1000000), threshold.TotalVolume)
+
+	_, err = service.ModifySession(context.Background(), &pb.ModifySessionRequest{
+		Count:        1,
+		BaseID:       1,
+		NodeBAddress: "10.0.0.1",
+		RemoveURRs:   true,
+	})
+	require.NoError(t, err)
+
+	modReq = sim.SentMessages()[3].(*message.SessionModificationRequest)
+	require.Len(t, modReq.RemoveURR, 1)
+
+	// removed URRs can no longer be updated
+	_, err = service.ModifySession(context.Background(), &pb.ModifySessionRequest{
+		Count:              1,
+		BaseID:             1,
+		NodeBAddress:       "10.0.0.1",
+		UrrVolumeThreshold: 2000000,
+	})
+	require.Error(t, err)
+}
+
+func TestMaxSessionCreationRate(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	// one session every 50ms
+	SetMaxSessionCreationRate(20)
+
+	start := time.Now()
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         5,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 4*50*time.Millisecond)
+
+	// the budget does not allow to wait for the next slots
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(operationBudgetMetadataKey, "60ms"))
+
+	_, err = service.CreateSession(ctx, &pb.CreateSessionRequest{
+		Count:         5,
+		BaseID:        51,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Less(t, getSessionCount(), 10)
+}
+
+func TestSessionCreationSlotCancel(t *testing.T) {
+	resetState()
+	t.Cleanup(resetState)
+
+	// one session every 200ms
+	SetMaxSessionCreationRate(5)
+
+	require.NoError(t, waitSessionCreationSlot(context.Background()))
+
+	// a caller giving up while waiting for the next slot does not reserve it
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	require.Equal(t, codes.ResourceExhausted, status.Code(waitSessionCreationSlot(ctx)))
+
+	start := time.Now()
+
+	// the next slot is 150ms away, instead of 350ms if reserved by the canceled caller
+	require.NoError(t, waitSessionCreationSlot(context.Background()))
+	require.Less(t, int64(time.Since(start)), int64(250*time.Millisecond))
+}
+
+func TestHonorOverloadReduction(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	// the emulated peer requests a 50% reduction in Session Establishment Responses
+	sim = pfcpsim.NewPFCPClient(pfcpsim.LoopbackAddress)
+	sim.ConnectLoopback(func(req message.Message) message.Message {
+		resp := pfcpsim.AcceptAllResponder(req)
+
+		if estResp, ok := resp.(*message.SessionEstablishmentResponse); ok {
+			estResp.OverloadControlInformation = ieLib.NewOverloadControlInformation(
+				ieLib.NewSequenceNumber(1),
+				ieLib.NewMetric(50),
+				ieLib.NewTimer(time.Minute),
+			)
+		}
+
+		return resp
+	})
+	remotePeerConnected = true
+	require.NoError(t, sim.SetupAssociation())
+
+	// one session every 50ms, until the overload is signaled
+	SetMaxSessionCreationRate(20)
+	honorOverloadReduction = true
+
+	start := time.Now()
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         5,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	})
+	require.NoError(t, err)
+
+	// the first interval is reserved before the overload is signaled, the next ones are doubled
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond+3*100*time.Millisecond)
+	require.Equal(t, float64(10), sessionCreationRate())
+
+	// when ignored, the reduction metric does not affect the rate
+	honorOverloadReduction = false
+	require.Equal(t, float64(20), sessionCreationRate())
+}
+
+func TestHonorOverloadReductionWithoutMaxRate(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	request := &pb.ConfigureRequest{
+		UpfN3Address:           "10.0.0.1",
+		HonorOverloadReduction: true,
+	}
+
+	// there is no rate to reduce
+	_, err := service.Configure(context.Background(), request)
+	require.Equal(t, codes.Aborted, status.Code(err))
+	require.False(t, honorOverloadReduction)
+
+	SetMaxSessionCreationRate(20)
+
+	_, err = service.Configure(context.Background(), request)
+	require.NoError(t, err)
+	require.True(t, honorOverloadReduction)
+}
+
+func TestHonorFullOverloadReduction(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	// the emulated peer requests to stop the traffic in Session Establishment Responses
+	sim = pfcpsim.NewPFCPClient(pfcpsim.LoopbackAddress)
+	sim.ConnectLoopback(func(req message.Message) message.Message {
+		resp := pfcpsim.AcceptAllResponder(req)
+
+		if estResp, ok := resp.(*message.SessionEstablishmentResponse); ok {
+			estResp.OverloadControlInformation = ieLib.NewOverloadControlInformation(
+				ieLib.NewSequenceNumber(1),
+				ieLib.NewMetric(100),
+				ieLib.NewTimer(time.Minute),
+			)
+		}
+
+		return resp
+	})
+	remotePeerConnected = true
+	require.NoError(t, sim.SetupAssociation())
+
+	SetMaxSessionCreationRate(20)
+	honorOverloadReduction = true
+
+	request := &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	}
+
+	// the overload is signaled once the first session is established
+	_, err := service.CreateSession(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, float64(0), sessionCreationRate())
+
+	// no session is established until the overload expires
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	request.BaseID = 11
+
+	_, err = service.CreateSession(ctx, request)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Equal(t, 1, getSessionCount())
+
+	// without deadline, session creation blocks until canceled
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+
+	_, err = service.CreateSession(ctx, request)
+	require.Error(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	require.Equal(t, 1, getSessionCount())
+}
+
+// newGTPUEchoResponder starts a GTP-U peer answering Echo Requests. Returns its address.
+func newGTPUEchoResponder(t *testing.T) string {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.ParseIP("127.0.0.1")})
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 1500)
+
+		for {
+			n, raddr, err := conn.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+
+			if n < 12 || buf[1] != 1 {
+				continue
+			}
+
+			// Echo Response carrying the sequence number of the request and a Recovery IE
+			resp := append([]byte{}, buf[:12]...)
+			resp[1] = 2
+			resp[3] = 6
+			resp = append(resp, 14, 0)
+
+			_, _ = conn.WriteToUDP(resp, raddr)
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+// newPFCPPeer starts a PFCP peer answering every request with responder. Returns its address and the types
+// of the received messages, excluding heartbeats.
+func newPFCPPeer(t *testing.T, responder pfcpsim.Responder) (string, func() []uint8) {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.ParseIP("127.0.0.1")})
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	var (
+		received []uint8
+		lock     sync.Mutex
+	)
+
+	go func() {
+		buf := make([]byte, 1500)
+
+		for {
+			n, raddr, err := conn.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+
+			req, err := message.Parse(buf[:n])
+			if err != nil {
+				continue
+			}
+
+			if req.MessageType() != message.MsgTypeHeartbeatRequest {
+				lock.Lock()
+				received = append(received, req.MessageType())
+				lock.Unlock()
+			}
+
+			resp := responder(req)
+			if resp == nil {
+				continue
+			}
+
+			b := make([]byte, resp.MarshalLen())
+			if err := resp.MarshalTo(b); err == nil {
+				_, _ = conn.WriteToUDP(b, raddr)
+			}
+		}
+	}()
+
+	return conn.LocalAddr().String(), func() []uint8 {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return append([]uint8{}, received...)
+	}
+}
+
+func TestAutoAssociateMode(t *testing.T) {
+	resetState()
+	t.Cleanup(resetState)
+
+	service := NewPFCPSimService("")
+	SetLocalAddress("127.0.0.1")
+
+	createRequest := &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	}
+
+	configure := func(peerAddress string) {
+		_, err := service.Configure(context.Background(), &pb.ConfigureRequest{
+			UpfN3Address:      "10.0.0.2",
+			RemotePeerAddress: peerAddress,
+			ResponseTimeoutMs: 200,
+		})
+		require.NoError(t, err)
+	}
+
+	peerAddress, received := newPFCPPeer(t, pfcpsim.AcceptAllResponder)
+	configure(peerAddress)
+
+	_, err := service.CreateSession(context.Background(), createRequest)
+	require.Equal(t, codes.Aborted, status.Code(err))
+	require.Contains(t, err.Error(), "Server is not associated")
+
+	SetAutoAssociateMode(true)
+
+	_, err = service.CreateSession(context.Background(), createRequest)
+	require.NoError(t, err)
+	require.True(t, isRemotePeerConnected())
+	require.Equal(t, 1, getSessionCount())
+	require.Equal(t, []uint8{
+		message.MsgTypeAssociationSetupRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+	}, received())
+
+	_, err = service.Disassociate(context.Background(), &pb.EmptyRequest{})
+	require.NoError(t, err)
+
+	// association failures are not masked
+	silentPeerAddress, _ := newPFCPPeer(t, func(req message.Message) message.Message { return nil })
+	configure(silentPeerAddress)
+
+	createRequest.BaseID = 11
+
+	_, err = service.CreateSession(context.Background(), createRequest)
+	require.Equal(t, codes.Aborted, status.Code(err))
+	require.Contains(t, err.Error(), "automatic association failed")
+	require.False(t, isRemotePeerConnected())
+}
+
+func TestCheckDataPath(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	res, err := service.CheckDataPath(context.Background(), &pb.CheckDataPathRequest{
+		N3Address: newGTPUEchoResponder(t),
+	})
+	require.NoError(t, err)
+	require.True(t, res.Reachable, res.Error)
+	require.Empty(t, res.Error)
+
+	// nobody is listening on the discard port
+	res, err = service.CheckDataPath(context.Background(), &pb.CheckDataPathRequest{
+		N3Address: "127.0.0.1:9",
+		TimeoutMs: 100,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Reachable)
+	require.NotEmpty(t, res.Error)
+}
+
+func TestCreateSessionWithInitialDownlinkAction(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:                 1,
+		BaseID:                1,
+		UeAddressPool:         "17.0.0.0/24",
+		AppFilters:            []string{"udp:any:80-80:allow:100"},
+		InitialDownlinkAction: pb.FARAction_BUFFER,
+	})
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+	require.Len(t, estReq.CreateFAR, 2)
+
+	downlinkFAR := estReq.CreateFAR[1]
+
+	applyAction, err := downlinkFAR.ApplyAction()
+	require.NoError(t, err)
+	require.Equal(t, session.ActionBuffer, applyAction)
+
+	_, err = downlinkFAR.OuterHeaderCreation()
+	require.Error(t, err)
+
+	_, err = service.ModifySession(context.Background(), &pb.ModifySessionRequest{
+		Count:        1,
+		BaseID:       1,
+		NodeBAddress: "10.0.0.1",
+		AppFilters:   []string{"udp:any:80-80:allow:100"},
+	})
+	require.NoError(t, err)
+
+	modReq := sim.SentMessages()[2].(*message.SessionModificationRequest)
+	require.Len(t, modReq.UpdateFAR, 1)
+
+	farID, err := modReq.UpdateFAR[0].FARID()
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), farID)
+
+	applyAction, err = modReq.UpdateFAR[0].ApplyAction()
+	require.NoError(t, err)
+	require.Equal(t, session.ActionForward, applyAction)
+
+	updateFAR, err := modReq.UpdateFAR[0].UpdateFAR()
+	require.NoError(t, err)
+
+	var ohc *ieLib.OuterHeaderCreationFields
+
+	for _, x := range updateFAR {
+		if x.Type == ieLib.UpdateForwardingParameters {
+			ohc, err = x.OuterHeaderCreation()
+			require.NoError(t, err)
+		}
+	}
+
+	require.NotNil(t, ohc)
+	require.Equal(t, "10.0.0.1", ohc.IPv4Address.String())
+}
+
+func TestConfigureNodeType(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	ctx := context.Background()
+
+	_, err := service.Configure(ctx, &pb.ConfigureRequest{
+		UpfN3Address:      pfcpsim.LoopbackAddress,
+		RemotePeerAddress: pfcpsim.LoopbackAddress,
+		NodeType:          pb.NodeType_SMF,
+	})
+	require.NoError(t, err)
+
+	_, err = service.Associate(ctx, &pb.EmptyRequest{})
+	require.NoError(t, err)
+
+	assocReq := sim.SentMessages()[0].(*message.AssociationSetupRequest)
+	require.NotNil(t, assocReq.CPFunctionFeatures)
+
+	features, err := assocReq.CPFunctionFeatures.CPFunctionFeatures()
+	require.NoError(t, err)
+	require.Equal(t, pfcpsim.NodeTypeSMF.CPFunctionFeatures(), features)
+	require.Equal(t, pfcpsim.CPFunctionFeatureLOAD|pfcpsim.CPFunctionFeatureOVRL, features)
+}
+
+func TestGetSession(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	ctx := context.Background()
+
+	_, err := service.CreateSession(ctx, &pb.CreateSessionRequest{
+		Count:         2,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"udp:any:80-80:allow:100"},
+		FiveQI:        9,
+		Qfi:           5,
+		UplinkMBR:     1000,
+		DownlinkMBR:   2000,
+		Urr:           &pb.URRSpec{Volume: true},
+	})
+	require.NoError(t, err)
+
+	res, err := service.GetSession(ctx, &pb.GetSessionRequest{SessionID: 11})
+	require.NoError(t, err)
+
+	sess, ok := getSession(11)
+	require.True(t, ok)
+
+	require.Equal(t, int32(11), res.SessionID)
+	require.Equal(t, sess.LocalSEID(), res.LocalSEID)
+	require.Equal(t, sess.PeerSEID(), res.PeerSEID)
+	require.Equal(t, "17.0.0.2", res.UeAddress)
+	require.Equal(t, uint32(11), res.UplinkTEID)
+	require.Equal(t, []uint32{11}, res.UrrIDs)
+
+	require.Len(t, res.Pdrs, 2)
+
+	for i, sourceInterface := range []uint8{ieLib.SrcInterfaceAccess, ieLib.SrcInterfaceCore} {
+		pdr := res.Pdrs[i]
+
+		require.Equal(t, uint32(11+i), pdr.Id)
+		require.Equal(t, uint32(100), pdr.Precedence)
+		require.Equal(t, uint32(sourceInterface), pdr.SourceInterface)
+		require.Equal(t, "permit out udp from any to assigned 80-80", pdr.SdfFilter)
+		require.Equal(t, uint32(11+i), pdr.FarID)
+		require.Equal(t, []uint32{0}, pdr.QerIDs)
+		require.Equal(t, []uint32{11}, pdr.UrrIDs)
+	}
+
+	require.Len(t, res.Fars, 2)
+
+	require.Equal(t, uint32(11), res.Fars[0].Id)
+	require.Equal(t, uint32(session.ActionForward), res.Fars[0].ApplyAction)
+	require.Empty(t, res.Fars[0].OuterHeaderAddress)
+
+	require.Equal(t, uint32(12), res.Fars[1].Id)
+	require.Equal(t, uint32(session.ActionForward), res.Fars[1].ApplyAction)
+	require.Equal(t, "10.0.0.1", res.Fars[1].OuterHeaderAddress)
+	require.Equal(t, uint32(11), res.Fars[1].OuterHeaderTEID)
+
+	require.Len(t, res.Qers, 1)
+	require.Equal(t, uint32(0), res.Qers[0].Id)
+	require.Equal(t, uint32(5), res.Qers[0].Qfi)
+	require.Equal(t, uint64(1000), res.Qers[0].UplinkMBR)
+	require.Equal(t, uint64(2000), res.Qers[0].DownlinkMBR)
+
+	_, err = service.GetSession(ctx, &pb.GetSessionRequest{SessionID: 21})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestModifySessionMissingIndex(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	ctx := context.Background()
+
+	_, err := service.CreateSession(ctx, &pb.CreateSessionRequest{
+		Count:         2,
+		BaseID:        11,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"udp:any:80-80:allow:100"},
+	})
+	require.NoError(t, err)
+
+	modifyRequest := &pb.ModifySessionRequest{
+		Count:        2,
+		BaseID:       1,
+		NodeBAddress: "10.0.0.2",
+		AppFilters:   []string{"udp:any:80-80:allow:100"},
+	}
+
+	// enough sessions are active, but the first one of the range is not
+	_, err = service.ModifySession(ctx, modifyRequest)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), "Session with index 1 is not active")
+
+	// no session was modified
+	require.Equal(t, []uint8{
+		message.MsgTypeAssociationSetupRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+	}, sentMessageTypes())
+
+	modifyRequest.IgnoreMissing = true
+
+	res, err := service.ModifySession(ctx, modifyRequest)
+	require.NoError(t, err)
+	require.Contains(t, res.Message, "1 sessions were modified, 1 missing sessions were skipped")
+
+	require.Equal(t, []uint8{
+		message.MsgTypeAssociationSetupRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+		message.MsgTypeSessionModificationRequest,
+	}, sentMessageTypes())
+}
+
+func TestCreateMBSSession(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"udp:any:80-80:allow:100"},
+		Mbs:           &pb.MBSSpec{MulticastAddress: "232.0.0.1", SourceAddress: "10.0.0.2"},
+	})
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+	require.Len(t, estReq.CreatePDR, 2)
+
+	// only the downlink PDR matches the multicast traffic
+	_, err = estReq.CreatePDR[0].IPMulticastAddressingInfo()
+	require.ErrorIs(t, err, ieLib.ErrIENotFound)
+
+	multicastAddress, err := estReq.CreatePDR[1].IPMulticastAddress()
+	require.NoError(t, err)
+	require.Equal(t, "232.0.0.1", multicastAddress.StartIPv4Address.String())
+
+	sourceAddress, err := estReq.CreatePDR[1].SourceIPAddress()
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.2", sourceAddress.IPv4Address.String())
+
+	_, err = service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        11,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		Mbs:           &pb.MBSSpec{MulticastAddress: "10.0.0.3"},
+	})
+	require.Error(t, err)
+}
+
+func TestCreateSessionWithSharedTrafficEndpoint(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:           1,
+		BaseID:          1,
+		NodeBAddress:    "10.0.0.1",
+		UeAddressPool:   "17.0.0.0/24",
+		AppFilters:      []string{"udp:any:80-80:allow:100"},
+		TrafficEndpoint: true,
+	})
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+	require.Len(t, estReq.CreateTrafficEndpoint, 1)
+	require.Len(t, estReq.CreatePDR, 2)
+
+	// the endpoint carries both the uplink F-TEID and the UE IP address
+	trafficEndpoint := estReq.CreateTrafficEndpoint[0]
+
+	trafficEndpointID, err := trafficEndpoint.TrafficEndpointID()
+	require.NoError(t, err)
+
+	fteid, err := trafficEndpoint.FTEID()
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), fteid.TEID)
+	require.Equal(t, upfN3Address, fteid.IPv4Address.String())
+
+	ueAddress, err := trafficEndpoint.UEIPAddress()
+	require.NoError(t, err)
+	require.Equal(t, "17.0.0.1", ueAddress.IPv4Address.String())
+
+	// both PDRs reference it
+	for _, pdr := range estReq.CreatePDR {
+		id, err := pdr.TrafficEndpointID()
+		require.NoError(t, err)
+		require.Equal(t, trafficEndpointID, id)
+
+		_, err = pdr.FTEID()
+		require.Error(t, err)
+
+		_, err = pdr.UEIPAddress()
+		require.Error(t, err)
+	}
+}
+
+func TestCreateSessionWithDropAppFilter(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"udp:any:80-80:drop:100", "tcp:any:80-80:deny:100"},
+	})
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+	require.Len(t, estReq.CreateFAR, 4)
+
+	for i, far := range estReq.CreateFAR {
+		applyAction, err := far.ApplyAction()
+		require.NoError(t, err)
+
+		if i < 2 {
+			// the drop filter is enforced by the FARs
+			require.Equal(t, session.ActionDrop, applyAction)
+		} else {
+			// the deny filter is enforced by the gate status
+			require.Equal(t, session.ActionForward, applyAction)
+		}
+	}
+}
+
+func TestModifySessionSequence(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         2,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"ip:any:any:allow:100"},
+	})
+	require.NoError(t, err)
+
+	start := time.Now()
+
+	_, err = service.ModifySession(context.Background(), &pb.ModifySessionRequest{
+		Count:        2,
+		BaseID:       1,
+		NodeBAddress: "10.0.0.2",
+		AppFilters:   []string{"ip:any:any:allow:100"},
+		Sequence: []*pb.ModificationStep{
+			{Action: pb.FARAction_BUFFER},
+			{Action: pb.FARAction_FORWARD, DelayMs: 20},
+			{Action: pb.FARAction_BUFFER, DelayMs: 20},
+		},
+	})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	var applyActions []uint8
+
+	// skip the association and the establishments
+	for _, msg := range sim.SentMessages()[3:] {
+		modReq, ok := msg.(*message.SessionModificationRequest)
+		if !ok {
+			continue
+		}
+
+		require.Len(t, modReq.UpdateFAR, 1)
+
+		applyAction, err := modReq.UpdateFAR[0].ApplyAction()
+		require.NoError(t, err)
+
+		applyActions = append(applyActions, applyAction)
+	}
+
+	buffer := session.ActionBuffer | session.ActionNotify
+
+	// each step modifies both sessions, in order
+	require.Equal(t, []uint8{buffer, buffer, session.ActionForward, session.ActionForward, buffer, buffer}, applyActions)
+
+	_, err = service.ModifySession(context.Background(), &pb.ModifySessionRequest{
+		Count:    2,
+		BaseID:   1,
+		Sequence: []*pb.ModificationStep{{Action: pb.FARAction_DROP}},
+	})
+	require.Error(t, err)
+}
+
+func TestCreateSessionWithTrafficSteering(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:           1,
+		BaseID:          1,
+		NodeBAddress:    "10.0.0.1",
+		UeAddressPool:   "17.0.0.0/24",
+		AppFilters:      []string{"udp:any:80-80:allow:100"},
+		TrafficSteering: &pb.TrafficSteering{UplinkPolicyID: "sfc-ul", DownlinkPolicyID: "sfc-dl"},
+	})
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[1].(*message.SessionEstablishmentRequest)
+	require.Len(t, estReq.CreateFAR, 2)
+
+	for i, expected := range []string{"sfc-ul", "sfc-dl"} {
+		fwdParams, err := estReq.CreateFAR[i].ForwardingParameters()
+		require.NoError(t, err)
+
+		var policyID string
+
+		for _, x := range fwdParams {
+			if x.Type == ieLib.ForwardingPolicy {
+				policyID, err = x.ForwardingPolicyIdentifier()
+				require.NoError(t, err)
+			}
+		}
+
+		require.Equal(t, expected, policyID)
+	}
+}
+
+func TestCreateSessionWithDefaultDNN(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	defaultDNN = "enterprise"
+
+	networkInstanceOf := func(ies []*ieLib.IE) string {
+		for _, x := range ies {
+			if x.Type == ieLib.NetworkInstance {
+				networkInstance, err := x.NetworkInstanceFQDN()
+				require.NoError(t, err)
+
+				return networkInstance
+			}
+		}
+
+		return ""
+	}
+
+	for _, scenario := range []struct {
+		dnn      string
+		expected string
+	}{
+		{dnn: "", expected: "enterprise"},
+		{dnn: "ims", expected: "ims"},
+	} {
+		_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+			Count:         1,
+			BaseID:        1,
+			NodeBAddress:  "10.0.0.1",
+			UeAddressPool: "17.0.0.0/24",
+			AppFilters:    []string{"udp:any:80-80:allow:100"},
+			Dnn:           scenario.dnn,
+		})
+		require.NoError(t, err)
+
+		estReq := sim.SentMessages()[len(sim.SentMessages())-1].(*message.SessionEstablishmentRequest)
+		require.Len(t, estReq.CreatePDR, 2)
+		require.Len(t, estReq.CreateFAR, 2)
+
+		downlinkPDI, err := estReq.CreatePDR[1].PDI()
+		require.NoError(t, err)
+		require.Equal(t, scenario.expected, networkInstanceOf(downlinkPDI))
+
+		uplinkFwdParams, err := estReq.CreateFAR[0].ForwardingParameters()
+		require.NoError(t, err)
+		require.Equal(t, scenario.expected, networkInstanceOf(uplinkFwdParams))
+
+		_, err = service.DeleteSession(context.Background(), &pb.DeleteSessionRequest{Count: 1, BaseID: 1})
+		require.NoError(t, err)
+	}
+}
+
+func TestCreateSessionTransactionID(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	hook := logTest.NewGlobal()
+	defer hook.Reset()
+
+	level := log.GetLevel()
+	log.SetLevel(log.DebugLevel)
+	defer log.SetLevel(level)
+
+	res, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         2,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	})
+	require.NoError(t, err)
+	require.Regexp(t, "^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$", res.TransactionID)
+
+	var sessionIDs []interface{}
+
+	for _, entry := range hook.AllEntries() {
+		if sessionID, ok := entry.Data["sessionID"]; ok {
+			require.Equal(t, res.TransactionID, entry.Data["transactionID"])
+			sessionIDs = append(sessionIDs, sessionID)
+		}
+	}
+
+	require.Equal(t, []interface{}{1, 11}, sessionIDs)
+
+	for _, id := range []int{1, 11} {
+		require.Equal(t, res.TransactionID, getSessionContext(id).transactionID)
+	}
+
+	// later operations on the sessions are logged with the ID of the transaction which created them
+	hook.Reset()
+
+	_, err = service.ModifySession(context.Background(), &pb.ModifySessionRequest{Count: 2, BaseID: 1, NodeBAddress: "10.0.0.1"})
+	require.NoError(t, err)
+
+	_, err = service.DeleteSession(context.Background(), &pb.DeleteSessionRequest{Count: 2, BaseID: 1})
+	require.NoError(t, err)
+
+	var messages []string
+
+	for _, entry := range hook.AllEntries() {
+		if _, ok := entry.Data["sessionID"]; ok {
+			require.Equal(t, res.TransactionID, entry.Data["transactionID"])
+			messages = append(messages, entry.Message)
+		}
+	}
+
+	require.Equal(t, []string{"Session modified", "Session modified", "Session deleted", "Session deleted"}, messages)
+}
+
+func TestSetup(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	res, err := service.Setup(context.Background(), &pb.SetupRequest{
+		Configure: &pb.ConfigureRequest{
+			UpfN3Address:      "10.0.0.2",
+			RemotePeerAddress: "127.0.0.1",
+		},
+		Sessions: &pb.CreateSessionRequest{
+			Count:         3,
+			BaseID:        1,
+			NodeBAddress:  "10.0.0.1",
+			UeAddressPool: "17.0.0.0/24",
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.TransactionID)
+
+	require.Equal(t, "10.0.0.2", upfN3Address)
+	require.True(t, isRemotePeerConnected())
+	require.Equal(t, 3, getSessionCount())
+
+	require.Equal(t, []uint8{
+		message.MsgTypeAssociationSetupRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+	}, sentMessageTypes())
+}
+
+func TestSetupRollback(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	require.NoError(t, setupLoopbackAssociation())
+
+	// the emulated peer rejects the 3rd session establishment only
+	var establishments int
+
+	sim.ConnectLoopback(func(req message.Message) message.Message {
+		if req.MessageType() == message.MsgTypeSessionEstablishmentRequest {
+			establishments++
+
+			if establishments == 3 {
+				return message.NewSessionEstablishmentResponse(0, 0, 0, req.Sequence(), 0,
+					ieLib.NewNodeID(pfcpsim.LoopbackAddress, "", ""), ieLib.NewCause(ieLib.CauseNoResourcesAvailable))
+			}
+		}
+
+		return pfcpsim.AcceptAllResponder(req)
+	})
+
+	request := &pb.SetupRequest{
+		Sessions: &pb.CreateSessionRequest{
+			Count:         4,
+			BaseID:        1,
+			NodeBAddress:  "10.0.0.1",
+			UeAddressPool: "17.0.0.0/24",
+		},
+	}
+
+	// the sessions created before the failure are deleted
+	_, err := service.Setup(context.Background(), request)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "2 sessions succeeded; session 3 (ID 21) failed")
+	require.Equal(t, 0, getSessionCount())
+	require.Equal(t, []uint8{
+		message.MsgTypeSessionEstablishmentRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+		message.MsgTypeSessionDeletionRequest,
+		message.MsgTypeSessionDeletionRequest,
+	}, sentMessageTypes())
+
+	// the next attempt succeeds once the failed one is rolled back
+	establishments = 0
+	request.Retries = 1
+
+	_, err = service.Setup(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, 4, getSessionCount())
+}
+
+func TestSetupRollbackSkippedSessions(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	// the active session uses the UE address of the 2nd session of the setup, which is skipped
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        100,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.1/24",
+	})
+	require.NoError(t, err)
+
+	// the emulated peer rejects the 3rd session establishment of the setup
+	var establishments int
+
+	sim.ConnectLoopback(func(req message.Message) message.Message {
+		if req.MessageType() == message.MsgTypeSessionEstablishmentRequest {
+			establishments++
+
+			if establishments == 3 {
+				return message.NewSessionEstablishmentResponse(0, 0, 0, req.Sequence(), 0,
+					ieLib.NewNodeID(pfcpsim.LoopbackAddress, "", ""), ieLib.NewCause(ieLib.CauseNoResourcesAvailable))
+			}
+		}
+
+		return pfcpsim.AcceptAllResponder(req)
+	})
+
+	_, err = service.Setup(context.Background(), &pb.SetupRequest{
+		Sessions: &pb.CreateSessionRequest{
+			Count:                    4,
+			BaseID:                   1,
+			NodeBAddress:             "10.0.0.1",
+			UeAddressPool:            "17.0.0.0/24",
+			DuplicateUEAddressPolicy: pb.DuplicateUEAddressPolicy_SKIP_DUPLICATES,
+			CheckActiveUEAddresses:   true,
+		},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "2 sessions succeeded; session 3 (ID 31) failed")
+
+	// only the sessions created by the setup are deleted
+	_, ok := getSession(100)
+	require.True(t, ok)
+	require.Equal(t, 1, getSessionCount())
+}
+
+func TestCreateSessionDuplicateUEAddress(t *testing.T) {
+	for _, scenario := range []struct {
+		description  string
+		policy       pb.DuplicateUEAddressPolicy
+		checkActive  bool
+		expectedCode codes.Code
+		// expectedIDs are the IDs of the active sessions once the colliding sessions are created
+		expectedIDs []int
+	}{
+		{
+			description:  "reject",
+			policy:       pb.DuplicateUEAddressPolicy_REJECT_DUPLICATES,
+			checkActive:  true,
+			expectedCode: codes.AlreadyExists,
+			expectedIDs:  []int{1},
+		},
+		{
+			description:  "skip",
+			policy:       pb.DuplicateUEAddressPolicy_SKIP_DUPLICATES,
+			checkActive:  true,
+			expectedCode: codes.OK,
+			expectedIDs:  []int{1, 21},
+		},
+		{
+			description:  "active sessions not checked",
+			policy:       pb.DuplicateUEAddressPolicy_REJECT_DUPLICATES,
+			expectedCode: codes.OK,
+			expectedIDs:  []int{1, 11, 21},
+		},
+	} {
+		t.Run(scenario.description, func(t *testing.T) {
+			service := newAssumeAssociatedService(t)
+
+			// session 1 gets the first address of the pool
+			_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+				Count:         1,
+				BaseID:        1,
+				NodeBAddress:  "10.0.0.1",
+				UeAddressPool: "17.0.0.0/24",
+			})
+			require.NoError(t, err)
+
+			// so does session 11, taken from the same pool
+			res, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+				Count:                    2,
+				BaseID:                   11,
+				NodeBAddress:             "10.0.0.1",
+				UeAddressPool:            "17.0.0.0/24",
+				DuplicateUEAddressPolicy: scenario.policy,
+				CheckActiveUEAddresses:   scenario.checkActive,
+			})
+			require.Equal(t, scenario.expectedCode, status.Code(err))
+
+			if scenario.policy == pb.DuplicateUEAddressPolicy_SKIP_DUPLICATES {
+				require.Contains(t, res.Message, "1 sessions with a duplicate UE address were skipped")
+			}
+
+			require.Equal(t, len(scenario.expectedIDs), getSessionCount())
+
+			for _, id := range scenario.expectedIDs {
+				_, ok := getSession(id)
+				require.True(t, ok)
+			}
+		})
+	}
+}
+
+func TestGetSessionMapping(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	ctx := context.Background()
+
+	_, err := service.CreateSession(ctx, &pb.CreateSessionRequest{
+		Count:         3,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	})
+	require.NoError(t, err)
+
+	_, err = service.DeleteSession(ctx, &pb.DeleteSessionRequest{Count: 1, BaseID: 11})
+	require.NoError(t, err)
+
+	res, err := service.GetSessionMapping(ctx, &pb.EmptyRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Sessions, 2)
+
+	for i, expectedID := range []int32{1, 21} {
+		mapping := res.Sessions[i]
+		require.Equal(t, expectedID, mapping.SessionID)
+
+		sess, err := service.GetSession(ctx, &pb.GetSessionRequest{SessionID: expectedID})
+		require.NoError(t, err)
+		require.Equal(t, sess.LocalSEID, mapping.LocalSEID)
+		require.Equal(t, sess.PeerSEID, mapping.PeerSEID)
+		require.Equal(t, sess.UeAddress, mapping.UeAddress)
+	}
+
+	require.Equal(t, "17.0.0.3", res.Sessions[1].UeAddress)
+}
+
+func TestCreateSessionTEIDRange(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	ctx := context.Background()
+
+	_, err := service.Configure(ctx, &pb.ConfigureRequest{
+		UpfN3Address: "10.0.0.2",
+		TeidRange:    &pb.TEIDRange{Min: 100, Max: 102},
+	})
+	require.NoError(t, err)
+
+	createRequest := &pb.CreateSessionRequest{
+		Count:         2,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"ip:any:any:allow:100"},
+	}
+
+	_, err = service.CreateSession(ctx, createRequest)
+	require.NoError(t, err)
+
+	// the range has a single TEID left
+	createRequest.BaseID = 21
+
+	_, err = service.CreateSession(ctx, createRequest)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Contains(t, err.Error(), "TEID range 100-102 is exhausted")
+	require.Equal(t, 3, getSessionCount())
+
+	// TEIDs of deleted sessions are allocated again
+	_, err = service.DeleteSession(ctx, &pb.DeleteSessionRequest{Count: 1, BaseID: 11})
+	require.NoError(t, err)
+
+	createRequest.Count = 1
+	createRequest.BaseID = 41
+
+	_, err = service.CreateSession(ctx, createRequest)
+	require.NoError(t, err)
+
+	expectedTEIDs := map[int]uint32{1: 100, 21: 102, 41: 101}
+	for id, expectedTEID := range expectedTEIDs {
+		require.Equal(t, expectedTEID, getSessionContext(id).uplinkTEID)
+	}
+
+	for _, msg := range sim.SentMessages() {
+		if estReq, ok := msg.(*message.SessionEstablishmentRequest); ok {
+			pdi, err := estReq.CreatePDR[0].PDI()
+			require.NoError(t, err)
+
+			for _, x := range pdi {
+				if x.Type == ieLib.FTEID {
+					fteid, err := x.FTEID()
+					require.NoError(t, err)
+					require.GreaterOrEqual(t, fteid.TEID, uint32(100))
+					require.LessOrEqual(t, fteid.TEID, uint32(102))
+				}
+			}
+		}
+	}
+
+	_, err = service.Configure(ctx, &pb.ConfigureRequest{
+		UpfN3Address: "10.0.0.2",
+		TeidRange:    &pb.TEIDRange{Min: 102, Max: 100},
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
+}
+
+func TestCreateSessionWithExplicitRuleIDs(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	request := &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"udp:any:80-80:allow:100,udp:any:81-81:deny:100"},
+		RuleIDs: []*pb.RuleIDs{
+			{UplinkPDRID: 7, DownlinkPDRID: 42, UplinkFARID: 70, DownlinkFARID: 420, UplinkQERID: 700, DownlinkQERID: 4200},
+			{UplinkPDRID: 8, DownlinkPDRID: 43, UplinkFARID: 71, DownlinkFARID: 421, UplinkQERID: 701, DownlinkQERID: 4201},
+		},
+	}
+
+	_, err := service.CreateSession(context.Background(), request)
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[len(sim.SentMessages())-1].(*message.SessionEstablishmentRequest)
+
+	var pdrIDs []uint16
+
+	for _, pdr := range estReq.CreatePDR {
+		pdrID, err := pdr.PDRID()
+		require.NoError(t, err)
+		pdrIDs = append(pdrIDs, pdrID)
+	}
+
+	require.Equal(t, []uint16{7, 42, 8, 43}, pdrIDs)
+
+	var farIDs []uint32
+
+	for _, far := range estReq.CreateFAR {
+		farID, err := far.FARID()
+		require.NoError(t, err)
+		farIDs = append(farIDs, farID)
+	}
+
+	require.Equal(t, []uint32{70, 420, 71, 421}, farIDs)
+
+	var qerIDs []uint32
+
+	for _, qer := range estReq.CreateQER {
+		qerID, err := qer.QERID()
+		require.NoError(t, err)
+		qerIDs = append(qerIDs, qerID)
+	}
+
+	// the session QER comes first
+	require.Equal(t, []uint32{0, 700, 4200, 701, 4201}, qerIDs)
+
+	// the second flow reuses the downlink PDR ID of the first one
+	request.BaseID = 11
+	request.RuleIDs[1].UplinkPDRID = 42
+
+	_, err = service.CreateSession(context.Background(), request)
+	require.Equal(t, codes.Aborted, status.Code(err))
+
+	// an application QER reuses the session QER ID
+	request.RuleIDs[1].UplinkPDRID = 8
+	request.RuleIDs[1].UplinkQERID = 0
+
+	_, err = service.CreateSession(context.Background(), request)
+	require.Equal(t, codes.Aborted, status.Code(err))
+
+	// rule IDs must be provided for each flow
+	request.RuleIDs = request.RuleIDs[:1]
+
+	_, err = service.CreateSession(context.Background(), request)
+	require.Equal(t, codes.Aborted, status.Code(err))
+	require.Equal(t, 1, getSessionCount())
+}
+
+func TestModifySessionWithExplicitRuleIDs(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	appFilters := []string{"udp:any:80-80:allow:100", "ip:any:any:deny:200"}
+
+	_, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         1,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    appFilters,
+		RuleIDs: []*pb.RuleIDs{
+			{UplinkPDRID: 7, DownlinkPDRID: 42, UplinkFARID: 70, DownlinkFARID: 420, UplinkQERID: 700, DownlinkQERID: 4200},
+			{UplinkPDRID: 8, DownlinkPDRID: 43, UplinkFARID: 71, DownlinkFARID: 421, UplinkQERID: 701, DownlinkQERID: 4201},
+		},
+	})
+	require.NoError(t, err)
+
+	// modifications update the downlink FARs the session was created with
+	_, err = service.ModifySession(context.Background(), &pb.ModifySessionRequest{
+		Count:        1,
+		BaseID:       1,
+		NodeBAddress: "10.0.0.1",
+		AppFilters:   appFilters,
+	})
+	require.NoError(t, err)
+
+	modReq := sim.SentMessages()[len(sim.SentMessages())-1].(*message.SessionModificationRequest)
+
+	var farIDs []uint32
+
+	for _, far := range modReq.UpdateFAR {
+		farID, err := far.FARID()
+		require.NoError(t, err)
+		farIDs = append(farIDs, farID)
+	}
+
+	require.Equal(t, []uint32{420, 421}, farIDs)
+}
+
+func TestCreateSessionPostCreateDelay(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	request := &pb.CreateSessionRequest{
+		Count:             1,
+		BaseID:            1,
+		NodeBAddress:      "10.0.0.1",
+		UeAddressPool:     "17.0.0.0/24",
+		PostCreateDelayMs: 200,
+	}
+
+	start := time.Now()
+
+	_, err := service.CreateSession(context.Background(), request)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+
+	// the budget expires during the delay: the session is established nonetheless
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(operationBudgetMetadataKey, "50ms"))
+	request.BaseID = 11
+
+	_, err = service.CreateSession(ctx, request)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	require.Equal(t, 2, getSessionCount())
+}
+
+func TestCreateSessionRuleStatus(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	// the emulated peer confirms the uplink PDRs only, allocating an F-TEID
+	sim = pfcpsim.NewPFCPClient(pfcpsim.LoopbackAddress)
+	sim.ConnectLoopback(func(req message.Message) message.Message {
+		resp := pfcpsim.AcceptAllResponder(req)
+
+		if estResp, ok := resp.(*message.SessionEstablishmentResponse); ok {
+			estResp.CreatedPDR = []*ieLib.IE{
+				ieLib.NewCreatedPDR(ieLib.NewPDRID(1), ieLib.NewFTEID(0x01, 0x100, net.ParseIP("198.18.0.1"), nil, 0)),
+				ieLib.NewCreatedPDR(ieLib.NewPDRID(3), ieLib.NewFTEID(0x01, 0x300, net.ParseIP("198.18.0.1"), nil, 0)),
+			}
+		}
+
+		return resp
+	})
+	remotePeerConnected = true
+	require.NoError(t, sim.SetupAssociation())
+
+	// two PDRs for the application filter, one for the uplink default rule
+	res, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:               1,
+		BaseID:              1,
+		NodeBAddress:        "10.0.0.1",
+		UeAddressPool:       "17.0.0.0/24",
+		AppFilters:          []string{"udp:any:80-80:allow:100"},
+		UplinkDefaultAction: pb.FARAction_DROP,
+		ReportRuleStatus:    true,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.RuleStatus, 1)
+	require.Equal(t, int32(1), res.RuleStatus[0].SessionID)
+
+	rules := res.RuleStatus[0].Rules
+	require.Len(t, rules, 3)
+
+	require.Equal(t, uint32(1), rules[0].PdrID)
+	require.True(t, rules[0].Confirmed)
+	require.Equal(t, uint32(0x100), rules[0].Teid)
+	require.Equal(t, "198.18.0.1", rules[0].N3Address)
+
+	require.Equal(t, uint32(2), rules[1].PdrID)
+	require.False(t, rules[1].Confirmed)
+
+	require.Equal(t, uint32(3), rules[2].PdrID)
+	require.True(t, rules[2].Confirmed)
+	require.Equal(t, uint32(0x300), rules[2].Teid)
+}
+
+func TestCreateSessionWithInactivityTimer(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	createRequest := &pb.CreateSessionRequest{
+		Count:             1,
+		BaseID:            1,
+		NodeBAddress:      "10.0.0.1",
+		UeAddressPool:     "17.0.0.0/24",
+		InactivityTimerMs: 1500,
+	}
+
+	_, err := service.CreateSession(context.Background(), createRequest)
+	require.Equal(t, codes.Aborted, status.Code(err))
+
+	// a sub-second timer is not truncated to zero
+	createRequest.InactivityTimerMs = 500
+
+	_, err = service.CreateSession(context.Background(), createRequest)
+	require.Equal(t, codes.Aborted, status.Code(err))
+	require.Equal(t, 0, getSessionCount())
+
+	createRequest.InactivityTimerMs = 30000
+
+	_, err = service.CreateSession(context.Background(), createRequest)
+	require.NoError(t, err)
+
+	estReq := sim.SentMessages()[len(sim.SentMessages())-1].(*message.SessionEstablishmentRequest)
+	require.NotNil(t, estReq.UserPlaneInactivityTimer)
+
+	timer, err := estReq.UserPlaneInactivityTimer.UserPlaneInactivityTimer()
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, timer)
+}
+
+func TestCreateSessionLatencyReport(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+
+	res, err := service.CreateSession(context.Background(), &pb.CreateSessionRequest{
+		Count:         3,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		ReportLatency: true,
+		WarmUpCount:   1,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res.Latency)
+	require.Equal(t, int32(2), res.Latency.Samples)
+	require.Equal(t, int32(1), res.Latency.WarmUpSessions)
+	require.LessOrEqual(t, res.Latency.P50Us, res.Latency.MaxUs)
+}
+
+func TestCreateSessionRuleLimits(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	ctx := context.Background()
+
+	_, err := service.Configure(ctx, &pb.ConfigureRequest{
+		UpfN3Address: "10.0.0.1",
+		RuleLimits:   &pb.RuleLimits{MaxPDRs: 2},
+	})
+	require.NoError(t, err)
+
+	createRequest := &pb.CreateSessionRequest{
+		Count:         2,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+		AppFilters:    []string{"udp:any:80-80:allow:100", "tcp:any:80-80:allow:100"},
+	}
+
+	// 2 application filters make 4 PDRs
+	_, err = service.CreateSession(ctx, createRequest)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), "Sessions have 4 PDRs, exceeding the limit of the remote peer: 2")
+
+	// nothing was sent
+	require.Equal(t, []uint8{message.MsgTypeAssociationSetupRequest}, sentMessageTypes())
+	require.Equal(t, 0, getSessionCount())
+
+	createRequest.AppFilters = createRequest.AppFilters[:1]
+
+	_, err = service.CreateSession(ctx, createRequest)
+	require.NoError(t, err)
+	require.Equal(t, 2, getSessionCount())
+}
+
+func TestDrain(t *testing.T) {
+	service := newAssumeAssociatedService(t)
+	ctx := context.Background()
+
+	createRequest := &pb.CreateSessionRequest{
+		Count:         2,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.1",
+		UeAddressPool: "17.0.0.0/24",
+	}
+
+	_, err := service.CreateSession(ctx, createRequest)
+	require.NoError(t, err)
+
+	_, err = service.SetDrain(ctx, &pb.SetDrainRequest{Drain: true})
+	require.NoError(t, err)
+
+	createRequest.BaseID = 21
+
+	_, err = service.CreateSession(ctx, createRequest)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, 2, getSessionCount())
+
+	// existing sessions can still be modified and deleted
+	_, err = service.ModifySession(ctx, &pb.ModifySessionRequest{
+		Count:         2,
+		BaseID:        1,
+		NodeBAddress:  "10.0.0.2",
+		UeAddressPool: "17.0.0.0/24",
+	})
+	require.NoError(t, err)
+
+	_, err = service.DeleteSession(ctx, &pb.DeleteSessionRequest{Count: 2, BaseID: 1})
+	require.NoError(t, err)
+	require.Equal(t, 0, getSessionCount())
+
+	_, err = service.SetDrain(ctx, &pb.SetDrainRequest{Drain: false})
+	require.NoError(t, err)
+
+	_, err = service.CreateSession(ctx, createRequest)
+	require.NoError(t, err)
+}
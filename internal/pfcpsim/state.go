@@ -4,40 +4,275 @@
 package pfcpsim
 
 import (
+	"sort"
 	"sync"
+	"time"
 
+	pb "github.com/infinitydon/pfcpsim/api"
 	"github.com/infinitydon/pfcpsim/pkg/pfcpsim"
+	ieLib "github.com/wmnsk/go-pfcp/ie"
 )
 
 var (
 	activeSessions     = make(map[int]*pfcpsim.PFCPSession, 0)
 	lockActiveSessions = new(sync.Mutex)
 
+	// sessionContexts hold the rules of each active session, guarded by lockActiveSessions
+	sessionContexts = make(map[int]sessionContext, 0)
+
+	// sessionHoldTimers delete the active sessions created with a hold time once it elapses, guarded by lockActiveSessions
+	sessionHoldTimers = make(map[int]holdTimer, 0)
+
+	// startHoldTimer calls f once the hold time d of a session has elapsed. It can be replaced to control time, e.g. in tests
+	startHoldTimer = func(d time.Duration, f func()) holdTimer { return time.AfterFunc(d, f) }
+
 	remotePeerAddress string
 	upfN3Address      string
 
+	// localAddress, if set, is the local address used with the remote peer, taking precedence over interfaceName
+	localAddress  string
 	interfaceName string
 
+	// socketOptions are applied to the UDP socket connected to the remote peer (see SetSocketOptions)
+	socketOptions pfcpsim.SocketOptions
+
+	// ieFilter selects the IEs included in the messages sent to the remote peer, for interoperability debugging
+	ieFilter pfcpsim.IEFilter
+
+	// responseTimeout is the time to wait for a PFCP response before retransmitting the request, up to retransmissions times.
+	// It is multiplied by timeoutBackoff after each retransmission
+	responseTimeout = pfcpsim.DefaultResponseTimeout
+	retransmissions int
+	timeoutBackoff  = 1.0
+
+	// maxPDRsPerSession, maxFARsPerSession and maxQERsPerSession are the rule limits of the remote peer. Zero means unlimited
+	maxPDRsPerSession uint32
+	maxFARsPerSession uint32
+	maxQERsPerSession uint32
+
+	// minTEID and maxTEID, if set, are the range the uplink TEIDs of the sessions are allocated from (see newTEIDAllocator)
+	minTEID uint32
+	maxTEID uint32
+
+	// recoveryTimeStamp, if set, is advertised in association setups and heartbeats instead of the start time
+	recoveryTimeStamp time.Time
+
+	// defaultDNN, if set, is the Network Instance of the sessions created without a DNN
+	defaultDNN string
+
+	// logSequenceWraparound logs whenever the PFCP sequence number wraps around (see onSequenceWraparound)
+	logSequenceWraparound bool
+
+	// nodeType is the CP function advertised in the association with the remote peer
+	nodeType pfcpsim.NodeType
+
+	// assumeAssociated makes the server use an emulated peer instead of the remote one (see SetAssumeAssociatedMode)
+	assumeAssociated bool
+
+	// autoAssociate makes session operations associate with the configured remote peer if not associated (see SetAutoAssociateMode)
+	autoAssociate bool
+
+	// validationStrictness governs how requests are validated and how non-fatal UPF responses are handled
+	validationStrictness = pb.Strictness_NORMAL
+
+	// associationCooldown is the minimum time between a disassociation and a new association attempt
+	associationCooldown time.Duration
+	lastDisassociation  time.Time
+
+	// maxSessionCreationRate is the server-wide ceiling of sessions established per second, regardless of the clients.
+	// nextSessionCreation is the earliest time the next session can be established without exceeding it
+	maxSessionCreationRate float64
+	nextSessionCreation    time.Time
+	lockSessionCreation    = new(sync.Mutex)
+
+	// throttleOnOverload makes session creation wait while the remote peer signals an overload
+	throttleOnOverload bool
+	// honorOverloadReduction reduces maxSessionCreationRate by the reduction metric of the overload signaled by the remote peer
+	honorOverloadReduction bool
+
+	// draining rejects the creation of new sessions, while existing ones can still be modified and deleted
+	draining bool
+
 	// Emulates 5G SMF/ 4G SGW
 	sim                 *pfcpsim.PFCPClient
 	remotePeerConnected bool
 )
 
-func insertSession(index int, session *pfcpsim.PFCPSession) {
+// holdTimer is the pending deletion of a session created with a hold time (see startHoldTimer).
+type holdTimer interface {
+	// Stop cancels the deletion, if still pending
+	Stop() bool
+}
+
+// sessionContext holds what is needed to modify the rules of an active session consistently with its creation.
+type sessionContext struct {
+	// transactionID identifies the CreateSession call the session was created by
+	transactionID string
+	// ruleIDOffset is the offset the rule IDs of the session were shifted by
+	ruleIDOffset uint32
+	// urrIDs are the IDs of the URRs of the session
+	urrIDs []uint32
+	// ueAddress is the UE address, or the delegated IPv6 prefix
+	ueAddress  string
+	uplinkTEID uint32
+	// pdrs, fars and qers are the rules the session was established with
+	pdrs []*ieLib.IE
+	fars []*ieLib.IE
+	qers []*ieLib.IE
+}
+
+func insertSession(index int, session *pfcpsim.PFCPSession, context sessionContext) {
 	lockActiveSessions.Lock()
 	defer lockActiveSessions.Unlock()
 
 	activeSessions[index] = session
+	sessionContexts[index] = context
 }
 
 func getSession(index int) (*pfcpsim.PFCPSession, bool) {
+	lockActiveSessions.Lock()
+	defer lockActiveSessions.Unlock()
+
 	element, ok := activeSessions[index]
 	return element, ok
 }
 
+func setSessionHoldTimer(index int, timer holdTimer) {
+	lockActiveSessions.Lock()
+	defer lockActiveSessions.Unlock()
+
+	sessionHoldTimers[index] = timer
+}
+
+func getSessionContext(index int) sessionContext {
+	lockActiveSessions.Lock()
+	defer lockActiveSessions.Unlock()
+
+	return sessionContexts[index]
+}
+
+// getSessionWithContext returns an active session along with its context, read atomically.
+func getSessionWithContext(index int) (*pfcpsim.PFCPSession, sessionContext, bool) {
+	lockActiveSessions.Lock()
+	defer lockActiveSessions.Unlock()
+
+	element, ok := activeSessions[index]
+
+	return element, sessionContexts[index], ok
+}
+
+func setSessionURRIDs(index int, urrIDs []uint32) {
+	lockActiveSessions.Lock()
+	defer lockActiveSessions.Unlock()
+
+	context := sessionContexts[index]
+	context.urrIDs = urrIDs
+	sessionContexts[index] = context
+}
+
+// isUEAddressActive returns true if an active session uses ueAddress, or the delegated IPv6 prefix.
+func isUEAddressActive(ueAddress string) bool {
+	lockActiveSessions.Lock()
+	defer lockActiveSessions.Unlock()
+
+	for _, context := range sessionContexts {
+		if context.ueAddress == ueAddress {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getUplinkTEIDs returns the uplink TEIDs used by the active sessions.
+func getUplinkTEIDs() map[uint32]bool {
+	lockActiveSessions.Lock()
+	defer lockActiveSessions.Unlock()
+
+	teids := make(map[uint32]bool, len(sessionContexts))
+
+	for _, context := range sessionContexts {
+		teids[context.uplinkTEID] = true
+	}
+
+	return teids
+}
+
+// getSessionMappings returns the SEIDs and UE address of every active session, sorted by session ID.
+func getSessionMappings() []*pb.SessionMapping {
+	lockActiveSessions.Lock()
+	defer lockActiveSessions.Unlock()
+
+	mappings := make([]*pb.SessionMapping, 0, len(activeSessions))
+
+	for index, session := range activeSessions {
+		mappings = append(mappings, &pb.SessionMapping{
+			SessionID: int32(index),
+			LocalSEID: session.LocalSEID(),
+			PeerSEID:  session.PeerSEID(),
+			UeAddress: sessionContexts[index].ueAddress,
+		})
+	}
+
+	sort.Slice(mappings, func(i, j int) bool {
+		return mappings[i].SessionID < mappings[j].SessionID
+	})
+
+	return mappings
+}
+
+// getMissingSessionID returns the ID of the first session which is not active
+// among the count sessions starting from baseID, if any.
+func getMissingSessionID(baseID int, count int) (int, bool) {
+	lockActiveSessions.Lock()
+	defer lockActiveSessions.Unlock()
+
+	for i := baseID; i < (count*SessionStep + baseID); i = i + SessionStep {
+		if _, ok := activeSessions[i]; !ok {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func getSessionCount() int {
+	lockActiveSessions.Lock()
+	defer lockActiveSessions.Unlock()
+
+	return len(activeSessions)
+}
+
 func deleteSession(index int) {
 	lockActiveSessions.Lock()
 	defer lockActiveSessions.Unlock()
 
+	removeSession(index)
+}
+
+// deleteSessionIfCurrent deletes the session with the given index only if it is still sess.
+// Returns false if the session was deleted, or replaced by another one, meanwhile.
+func deleteSessionIfCurrent(index int, sess *pfcpsim.PFCPSession) bool {
+	lockActiveSessions.Lock()
+	defer lockActiveSessions.Unlock()
+
+	if current, ok := activeSessions[index]; !ok || current != sess {
+		return false
+	}
+
+	removeSession(index)
+
+	return true
+}
+
+// removeSession removes the session with the given index and stops its hold timer, if any.
+// lockActiveSessions must be held.
+func removeSession(index int) {
 	delete(activeSessions, index)
+	delete(sessionContexts, index)
+
+	if timer, ok := sessionHoldTimers[index]; ok {
+		timer.Stop()
+		delete(sessionHoldTimers, index)
+	}
 }
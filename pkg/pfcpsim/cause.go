@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"fmt"
+
+	ieLib "github.com/wmnsk/go-pfcp/ie"
+)
+
+// causeNames maps Cause values to their names. Refer to table 8.2.1-1 in 3GPP TS 29.244.
+var causeNames = map[uint8]string{
+	ieLib.CauseRequestAccepted:                 "Request accepted",
+	ieLib.CauseRequestRejected:                 "Request rejected",
+	ieLib.CauseSessionContextNotFound:          "Session context not found",
+	ieLib.CauseMandatoryIEMissing:              "Mandatory IE missing",
+	ieLib.CauseConditionalIEMissing:            "Conditional IE missing",
+	ieLib.CauseInvalidLength:                   "Invalid length",
+	ieLib.CauseMandatoryIEIncorrect:            "Mandatory IE incorrect",
+	ieLib.CauseInvalidForwardingPolicy:         "Invalid Forwarding Policy",
+	ieLib.CauseInvalidFTEIDAllocationOption:    "Invalid F-TEID allocation option",
+	ieLib.CauseNoEstablishedPFCPAssociation:    "No established PFCP Association",
+	ieLib.CauseRuleCreationModificationFailure: "Rule creation/modification Failure",
+	ieLib.CausePFCPEntityInCongestion:          "PFCP entity in congestion",
+	ieLib.CauseNoResourcesAvailable:            "No resources available",
+	ieLib.CauseServiceNotSupported:             "Service not supported",
+	ieLib.CauseSystemFailure:                   "System failure",
+	ieLib.CauseRedirectionRequested:            "Redirection Requested",
+}
+
+// CauseName returns a human-readable name for cause, including its value.
+func CauseName(cause uint8) string {
+	name, ok := causeNames[cause]
+	if !ok {
+		name = "Unknown cause"
+	}
+
+	return fmt.Sprintf("%v (%v)", name, cause)
+}
+
+// IsRetryableCause returns true if a request rejected with cause may succeed when sent again later,
+// i.e. the peer is temporarily unable to handle it.
+func IsRetryableCause(cause uint8) bool {
+	switch cause {
+	case ieLib.CausePFCPEntityInCongestion, ieLib.CauseNoResourcesAvailable, ieLib.CauseSystemFailure:
+		return true
+	default:
+		return false
+	}
+}
@@ -76,3 +76,17 @@ func NewInvalidResponseError(err ...error) *pfcpSimError {
 		error:   err,
 	}
 }
+
+func NewAssociationRejectedError(cause uint8, err ...error) *pfcpSimError {
+	return &pfcpSimError{
+		message: fmt.Sprintf("Association rejected by peer with cause: %v", CauseName(cause)),
+		error:   err,
+	}
+}
+
+func NewSourceAddressMismatchError(sourceAddress string, localAddress string, err ...error) *pfcpSimError {
+	return &pfcpSimError{
+		message: fmt.Sprintf("Source address %v does not match the local address %v", sourceAddress, localAddress),
+		error:   err,
+	}
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+)
+
+// GTPUPort is the UDP port of GTP-U. Refer to section 4.4.2 in 3GPP TS 29.281.
+const GTPUPort = 2152
+
+const (
+	// gtpuFlags are the flags of the GTP-U header: version 1, protocol type GTP and sequence number present
+	gtpuFlags uint8 = 0x32
+
+	gtpuEchoRequest  uint8 = 1
+	gtpuEchoResponse uint8 = 2
+
+	// gtpuEchoLength is the length of an Echo Request, including the 8 bytes of the mandatory header
+	gtpuEchoLength = 12
+)
+
+// gtpuSequenceNumber is the sequence number of the last GTP-U Echo Request sent
+var gtpuSequenceNumber uint32
+
+// newGTPUEchoRequest returns a GTP-U Echo Request with the given sequence number.
+func newGTPUEchoRequest(seq uint16) []byte {
+	b := make([]byte, gtpuEchoLength)
+	b[0] = gtpuFlags
+	b[1] = gtpuEchoRequest
+	// length of the payload following the mandatory header: sequence number, N-PDU number and next extension header type
+	binary.BigEndian.PutUint16(b[2:4], 4)
+	// the TEID of path management messages is 0
+	binary.BigEndian.PutUint16(b[8:10], seq)
+
+	return b
+}
+
+// isGTPUEchoResponse returns true if b is a GTP-U Echo Response with the given sequence number.
+func isGTPUEchoResponse(b []byte, seq uint16) bool {
+	return len(b) >= gtpuEchoLength && b[0]>>5 == 1 && b[0]&0x02 != 0 &&
+		b[1] == gtpuEchoResponse && binary.BigEndian.Uint16(b[8:10]) == seq
+}
+
+// SendGTPUEcho sends a GTP-U Echo Request to the GTP-U peer and awaits for the Echo Response, to check the data path.
+// peerAddress can include a port (e.g. 10.0.0.1:2152), otherwise GTPUPort is used.
+// Returns the round-trip time, or error if no response is received within timeout.
+func SendGTPUEcho(peerAddress string, timeout time.Duration) (time.Duration, error) {
+	addr := net.JoinHostPort(peerAddress, fmt.Sprint(GTPUPort))
+
+	if host, port, err := net.SplitHostPort(peerAddress); err == nil {
+		// peerAddress contains also a port. Use provided port instead of GTPUPort
+		addr = net.JoinHostPort(host, port)
+	}
+
+	raddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return 0, err
+	}
+
+	conn, err := net.DialUDP("udp", nil, raddr)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	seq := uint16(atomic.AddUint32(&gtpuSequenceNumber, 1))
+	start := time.Now()
+
+	if err := conn.SetDeadline(start.Add(timeout)); err != nil {
+		return 0, err
+	}
+
+	if _, err := conn.Write(newGTPUEchoRequest(seq)); err != nil {
+		return 0, err
+	}
+
+	buf := make([]byte, DefaultMTU)
+
+	for {
+		n, err := conn.Read(buf)
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return 0, NewTimeoutExpiredError(err)
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		if isGTPUEchoResponse(buf[:n], seq) {
+			return time.Since(start), nil
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	ieLib "github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// IEFilter selects the IEs included in sent messages, e.g. to narrow down which IE a peer rejects.
+// IEs are matched by type, at any nesting level.
+type IEFilter struct {
+	// Include, if not empty, lists the types of the optional IEs to include. Other optional IEs are omitted
+	Include []uint16
+	// Exclude lists the types of the IEs to omit, even if mandatory. It takes precedence over Include
+	Exclude []uint16
+}
+
+// mandatoryIETypes are the types of the IEs mandatory in the messages built by the client,
+// which are never omitted because of IEFilter.Include. Refer to 3GPP TS 29.244 section 7.
+var mandatoryIETypes = map[uint16]bool{
+	ieLib.NodeID:               true,
+	ieLib.RecoveryTimeStamp:    true,
+	ieLib.FSEID:                true,
+	ieLib.CreatePDR:            true,
+	ieLib.PDRID:                true,
+	ieLib.Precedence:           true,
+	ieLib.PDI:                  true,
+	ieLib.SourceInterface:      true,
+	ieLib.CreateFAR:            true,
+	ieLib.UpdateFAR:            true,
+	ieLib.FARID:                true,
+	ieLib.ApplyAction:          true,
+	ieLib.DestinationInterface: true,
+	ieLib.CreateQER:            true,
+	ieLib.QERID:                true,
+	ieLib.GateStatus:           true,
+	ieLib.CreateURR:            true,
+	ieLib.URRID:                true,
+	ieLib.MeasurementMethod:    true,
+	ieLib.ReportingTriggers:    true,
+	ieLib.RemovePDR:            true,
+}
+
+// IsEmpty returns true if the filter keeps every IE.
+func (f IEFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// keeps returns true if IEs of type ieType are included in sent messages.
+func (f IEFilter) keeps(ieType uint16) bool {
+	for _, excluded := range f.Exclude {
+		if ieType == excluded {
+			return false
+		}
+	}
+
+	if len(f.Include) == 0 || mandatoryIETypes[ieType] {
+		return true
+	}
+
+	for _, included := range f.Include {
+		if ieType == included {
+			return true
+		}
+	}
+
+	return false
+}
+
+// filterIEs returns the IEs kept by the filter, filtering the child IEs of grouped IEs as well.
+func (f IEFilter) filterIEs(ies []*ieLib.IE) []*ieLib.IE {
+	var kept []*ieLib.IE
+
+	for _, ie := range ies {
+		if !f.keeps(ie.Type) {
+			continue
+		}
+
+		if ie.IsGrouped() {
+			ie = ieLib.NewGroupedIE(ie.Type, f.filterIEs(ie.ChildIEs)...)
+		}
+
+		kept = append(kept, ie)
+	}
+
+	return kept
+}
+
+// SetIEFilter sets the filter selecting the IEs included in the messages sent afterwards.
+func (c *PFCPClient) SetIEFilter(filter IEFilter) {
+	c.ieFilter = filter
+}
+
+// applyIEFilter returns b, the encoded message, without the IEs omitted by the filter.
+func (c *PFCPClient) applyIEFilter(b []byte) ([]byte, error) {
+	if c.ieFilter.IsEmpty() {
+		return b, nil
+	}
+
+	msg, err := message.ParseGeneric(b)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.IEs = c.ieFilter.filterIEs(msg.IEs)
+	msg.SetLength()
+
+	return msg.Marshal()
+}
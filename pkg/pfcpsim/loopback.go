@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"net"
+	"time"
+
+	ieLib "github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// LoopbackAddress is the address used by the emulated peer when the client is connected in loopback.
+const LoopbackAddress = "127.0.0.1"
+
+// Responder returns the message an emulated peer sends back upon receiving req.
+// Returning nil emulates a peer not answering the request.
+type Responder func(req message.Message) message.Message
+
+// ConnectLoopback connects the client to an in-process emulated peer instead of a remote one.
+// Every message sent by the client is encoded, decoded back and recorded (see SentMessages),
+// then answered by responder. It can be used to test rule-building logic without any UPF.
+func (c *PFCPClient) ConnectLoopback(responder Responder) {
+	c.sentLock.Lock()
+	defer c.sentLock.Unlock()
+
+	c.responder = responder
+	c.sentMessages = nil
+}
+
+// disconnectLoopback disconnects the client from the emulated peer. Sent messages are kept.
+func (c *PFCPClient) disconnectLoopback() {
+	c.sentLock.Lock()
+	defer c.sentLock.Unlock()
+
+	c.responder = nil
+}
+
+// SentMessages returns the messages sent while connected in loopback, in sending order.
+func (c *PFCPClient) SentMessages() []message.Message {
+	c.sentLock.Lock()
+	defer c.sentLock.Unlock()
+
+	return append([]message.Message{}, c.sentMessages...)
+}
+
+// sendToLoopback records b and forwards the response of the emulated peer, if any.
+func (c *PFCPClient) sendToLoopback(b []byte) error {
+	msg, err := message.Parse(b)
+	if err != nil {
+		return err
+	}
+
+	c.sentLock.Lock()
+	c.sentMessages = append(c.sentMessages, msg)
+	responder := c.responder
+	c.sentLock.Unlock()
+
+	if responder == nil {
+		// disconnected meanwhile
+		return nil
+	}
+
+	resp := responder(msg)
+	if resp == nil {
+		return nil
+	}
+
+	c.dispatch(resp)
+
+	return nil
+}
+
+// AcceptAllResponder emulates a peer accepting every request.
+func AcceptAllResponder(req message.Message) message.Message {
+	nodeID := ieLib.NewNodeID(LoopbackAddress, "", "")
+	accepted := ieLib.NewCause(ieLib.CauseRequestAccepted)
+
+	switch req := req.(type) {
+	case *message.AssociationSetupRequest:
+		return message.NewAssociationSetupResponse(req.Sequence(), nodeID, accepted, ieLib.NewRecoveryTimeStamp(time.Now()))
+	case *message.AssociationReleaseRequest:
+		return message.NewAssociationReleaseResponse(req.Sequence(), nodeID, accepted)
+	case *message.HeartbeatRequest:
+		return message.NewHeartbeatResponse(req.Sequence(), ieLib.NewRecoveryTimeStamp(time.Now()))
+	case *message.SessionEstablishmentRequest:
+		fseid, err := req.CPFSEID.FSEID()
+		if err != nil {
+			return nil
+		}
+
+		return message.NewSessionEstablishmentResponse(0, 0, fseid.SEID, req.Sequence(), 0,
+			nodeID, accepted, ieLib.NewFSEID(fseid.SEID, net.ParseIP(LoopbackAddress), nil))
+	case *message.SessionModificationRequest:
+		return message.NewSessionModificationResponse(0, 0, req.SEID(), req.Sequence(), 0, accepted)
+	case *message.SessionDeletionRequest:
+		return message.NewSessionDeletionResponse(0, 0, req.SEID(), req.Sequence(), 0, accepted)
+	}
+
+	return nil
+}
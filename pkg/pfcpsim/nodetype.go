@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+// NodeType is the CP function the client acts as.
+type NodeType int
+
+const (
+	// NodeTypeUnspecified advertises no CP function features.
+	NodeTypeUnspecified NodeType = iota
+	NodeTypeSMF
+	NodeTypeSGWC
+	// NodeTypeCombined is a combined SGW-C/PGW-C+SMF.
+	NodeTypeCombined
+)
+
+// CP Function Features flags (refer to 3GPP TS 29.244, section 8.2.58).
+const (
+	CPFunctionFeatureLOAD  uint8 = 0x01
+	CPFunctionFeatureOVRL  uint8 = 0x02
+	CPFunctionFeatureEPFAR uint8 = 0x04
+	CPFunctionFeatureSSET  uint8 = 0x08
+	CPFunctionFeatureBUNDL uint8 = 0x10
+	CPFunctionFeatureMPAS  uint8 = 0x20
+	CPFunctionFeatureARDR  uint8 = 0x40
+	CPFunctionFeatureUIAUR uint8 = 0x80
+)
+
+func (t NodeType) String() string {
+	switch t {
+	case NodeTypeSMF:
+		return "SMF"
+	case NodeTypeSGWC:
+		return "SGW-C"
+	case NodeTypeCombined:
+		return "SGW-C/SMF"
+	default:
+		return "unspecified"
+	}
+}
+
+// CPFunctionFeatures returns the features advertised in the association by the node type.
+// Only load and overload control are advertised, as the client implements no other feature (see LoadControl and OverloadControl).
+func (t NodeType) CPFunctionFeatures() uint8 {
+	switch t {
+	case NodeTypeSMF, NodeTypeSGWC, NodeTypeCombined:
+		return CPFunctionFeatureLOAD | CPFunctionFeatureOVRL
+	default:
+		return 0
+	}
+}
+
+// SetNodeType sets the CP function advertised in the association setups sent afterwards.
+func (c *PFCPClient) SetNodeType(nodeType NodeType) {
+	c.nodeType = nodeType
+}
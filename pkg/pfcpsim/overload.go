@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"time"
+
+	ieLib "github.com/wmnsk/go-pfcp/ie"
+)
+
+// LoadControlInfo is the load advertised by the peer through the Load Control Information IE.
+type LoadControlInfo struct {
+	SequenceNumber uint32
+	// Metric is the load of the peer, in percentage
+	Metric uint8
+	// ReceivedAt is the time the information was received. Zero if the peer never advertised its load
+	ReceivedAt time.Time
+}
+
+// OverloadControlInfo is the overload advertised by the peer through the Overload Control Information IE.
+type OverloadControlInfo struct {
+	SequenceNumber uint32
+	// ReductionMetric is the percentage of traffic the peer requests to reduce
+	ReductionMetric uint8
+	// Validity is the period, starting from ReceivedAt, during which the overload applies
+	Validity time.Duration
+	// ReceivedAt is the time the information was received. Zero if the peer never advertised an overload
+	ReceivedAt time.Time
+}
+
+// ExpiresAt returns the time the overload stops applying.
+func (o OverloadControlInfo) ExpiresAt() time.Time {
+	return o.ReceivedAt.Add(o.Validity)
+}
+
+// IsActive returns true if the peer requested a traffic reduction, whose validity period has not expired yet.
+func (o OverloadControlInfo) IsActive() bool {
+	return o.ReductionMetric != 0 && time.Now().Before(o.ExpiresAt())
+}
+
+// LoadControl returns the latest load advertised by the peer.
+func (c *PFCPClient) LoadControl() LoadControlInfo {
+	c.controlInfoLock.Lock()
+	defer c.controlInfoLock.Unlock()
+
+	return c.loadControl
+}
+
+// OverloadControl returns the latest overload advertised by the peer.
+func (c *PFCPClient) OverloadControl() OverloadControlInfo {
+	c.controlInfoLock.Lock()
+	defer c.controlInfoLock.Unlock()
+
+	return c.overloadControl
+}
+
+// updateControlInfo stores the load and overload advertised in a session response, if any.
+// As per 3GPP TS 29.244 section 6.2.4, information is discarded unless it is newer than the stored one.
+func (c *PFCPClient) updateControlInfo(load *ieLib.IE, overload *ieLib.IE) {
+	c.controlInfoLock.Lock()
+	defer c.controlInfoLock.Unlock()
+
+	now := time.Now()
+
+	if load != nil {
+		seq, seqErr := load.SequenceNumber()
+		metric, metricErr := load.Metric()
+
+		if seqErr == nil && metricErr == nil && (c.loadControl.ReceivedAt.IsZero() || seq > c.loadControl.SequenceNumber) {
+			c.loadControl = LoadControlInfo{
+				SequenceNumber: seq,
+				Metric:         metric,
+				ReceivedAt:     now,
+			}
+		}
+	}
+
+	if overload != nil {
+		seq, seqErr := overload.SequenceNumber()
+		metric, metricErr := overload.Metric()
+		validity, validityErr := overload.Timer()
+
+		if seqErr == nil && metricErr == nil && validityErr == nil &&
+			(c.overloadControl.ReceivedAt.IsZero() || seq > c.overloadControl.SequenceNumber) {
+			c.overloadControl = OverloadControlInfo{
+				SequenceNumber:  seq,
+				ReductionMetric: metric,
+				Validity:        validity,
+				ReceivedAt:      now,
+			}
+		}
+	}
+}
@@ -5,9 +5,11 @@ package pfcpsim
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	ieLib "github.com/wmnsk/go-pfcp/ie"
@@ -18,16 +20,27 @@ const (
 	PFCPStandardPort       = 8805
 	DefaultHeartbeatPeriod = 5
 	DefaultResponseTimeout = 5 * time.Second
+
+	DefaultAssociationRetries       = 0
+	DefaultAssociationRetryInterval = time.Second
+
+	// receiveBufferSize is the number of received messages buffered until they are read.
+	// Messages received while the buffer is full are dropped, as if lost
+	receiveBufferSize = 64
+
+	// MaxSequenceNumber is the highest sequence number encoded in the 24 bits of the PFCP header.
+	// The next sequence number wraps around to 0
+	MaxSequenceNumber = 0xffffff
 )
 
 // PFCPClient enables to simulate a client sending PFCP messages towards the UPF.
 // It provides two usage modes:
-// - 1st mode enables high-level PFCP operations (e.g., SetupAssociation())
+// - 1st mode enables high-level PFCP operations (e.g., SetupAssociation()), which can be called concurrently
 // - 2nd mode gives a user more control over PFCP sequence flow
 //   and enables send and receive of individual messages (e.g., SendAssociationSetupRequest(), PeekNextResponse())
 type PFCPClient struct {
 	// keeps the current number of active PFCP sessions
-	// it is also used as F-SEID
+	// it is also used as F-SEID, and accessed atomically as sessions can be established concurrently
 	lastFSEID uint64
 
 	aliveLock           sync.Mutex
@@ -41,12 +54,54 @@ type PFCPClient struct {
 
 	sequenceNumber uint32
 	seqNumLock     sync.Mutex
+	// onSequenceWraparound, if set, is called when the sequence number wraps around (see SetSequenceWraparoundHandler)
+	onSequenceWraparound func()
 
 	localAddr string
 	conn      *net.UDPConn
 
 	// responseTimeout timeout to wait for PFCP response (default: 5 seconds)
 	responseTimeout time.Duration
+
+	// socketOptions are applied to the UDP socket upon connection (see SetSocketOptions)
+	socketOptions SocketOptions
+
+	// ieFilter selects the IEs included in sent messages (see SetIEFilter)
+	ieFilter IEFilter
+
+	// nodeType is the CP function advertised in association setups (see SetNodeType)
+	nodeType NodeType
+
+	// recoveryTimeStamp is advertised in association setups and heartbeats (see SetRecoveryTimeStamp)
+	recoveryTimeStamp time.Time
+	recoveryLock      sync.Mutex
+
+	// retransmissions is the number of times an unanswered request is sent again (see SetRetransmissionPolicy).
+	// timeoutBackoff multiplies the time to wait for a response after each retransmission
+	retransmissions int
+	timeoutBackoff  float64
+	// lastRequest is the last request sent through the 2nd usage mode, retransmitted as is by PeekNextResponse
+	lastRequest     []byte
+	lastRequestLock sync.Mutex
+
+	// pendingRequests route the responses to the requests of high-level operations awaiting them,
+	// by sequence number (see transact)
+	pendingRequests map[uint32]chan message.Message
+	pendingLock     sync.Mutex
+
+	// associationRetries is the number of times an association setup rejected with a retryable cause is retried
+	associationRetries       int
+	associationRetryInterval time.Duration
+
+	// loadControl and overloadControl are the latest load and overload advertised by the peer
+	loadControl     LoadControlInfo
+	overloadControl OverloadControlInfo
+	controlInfoLock sync.Mutex
+
+	// responder emulates the remote peer when connected in loopback (see ConnectLoopback)
+	responder    Responder
+	sentMessages []message.Message
+	sentLock     sync.Mutex
 }
 
 func NewPFCPClient(localAddr string) *PFCPClient {
@@ -54,11 +109,18 @@ func NewPFCPClient(localAddr string) *PFCPClient {
 		sequenceNumber:  0,
 		localAddr:       localAddr,
 		responseTimeout: DefaultResponseTimeout,
+		timeoutBackoff:  1,
+		// the start time of the simulator
+		recoveryTimeStamp: time.Now(),
+
+		associationRetries:       DefaultAssociationRetries,
+		associationRetryInterval: DefaultAssociationRetryInterval,
 	}
 
 	client.ctx = context.Background()
-	client.heartbeatsChan = make(chan *message.HeartbeatResponse)
-	client.recvChan = make(chan message.Message)
+	client.heartbeatsChan = make(chan *message.HeartbeatResponse, receiveBufferSize)
+	client.recvChan = make(chan message.Message, receiveBufferSize)
+	client.pendingRequests = make(map[uint32]chan message.Message)
 
 	return client
 }
@@ -67,18 +129,90 @@ func (c *PFCPClient) SetPFCPResponseTimeout(timeout time.Duration) {
 	c.responseTimeout = timeout
 }
 
-func (c *PFCPClient) getNextSequenceNumber() uint32 {
+// RecoveryTimeStamp returns the time stamp advertised in association setups and heartbeats.
+func (c *PFCPClient) RecoveryTimeStamp() time.Time {
+	c.recoveryLock.Lock()
+	defer c.recoveryLock.Unlock()
+
+	return c.recoveryTimeStamp
+}
+
+// SetRecoveryTimeStamp sets the time stamp advertised in association setups and heartbeats sent afterwards.
+// By default it is the time the client was created.
+func (c *PFCPClient) SetRecoveryTimeStamp(timeStamp time.Time) {
+	c.recoveryLock.Lock()
+	defer c.recoveryLock.Unlock()
+
+	c.recoveryTimeStamp = timeStamp
+}
+
+// BumpRecoveryTimeStamp advances the recovery time stamp as if the client restarted, so that the peer
+// detects the restart from the next heartbeat. Since the IE has a granularity of one second,
+// the new time stamp is at least one second later than the previous one. It returns the new time stamp.
+func (c *PFCPClient) BumpRecoveryTimeStamp() time.Time {
+	c.recoveryLock.Lock()
+	defer c.recoveryLock.Unlock()
+
+	timeStamp := time.Now().Truncate(time.Second)
+	if !timeStamp.After(c.recoveryTimeStamp.Truncate(time.Second)) {
+		timeStamp = c.recoveryTimeStamp.Truncate(time.Second).Add(time.Second)
+	}
+
+	c.recoveryTimeStamp = timeStamp
+
+	return timeStamp
+}
+
+// SetAssociationRetryPolicy sets how many times, and how often, an association setup is retried
+// when the peer rejects it with a retryable cause (see IsRetryableCause). By default, it is not retried.
+// Any other cause makes SetupAssociation fail immediately.
+func (c *PFCPClient) SetAssociationRetryPolicy(retries int, interval time.Duration) {
+	c.associationRetries = retries
+	c.associationRetryInterval = interval
+}
+
+// SetRetransmissionPolicy sets how many times a request is sent again when no response is received in time,
+// and how the time to wait for a response grows across retransmissions: it is multiplied by backoffMultiplier
+// after each of them (e.g. with a response timeout of 1 second and a multiplier of 2, the request is retransmitted
+// after 1, 3 and 7 seconds). A multiplier of 1 keeps the response timeout fixed. Zero retransmissions disables them.
+func (c *PFCPClient) SetRetransmissionPolicy(retransmissions int, backoffMultiplier float64) {
+	c.retransmissions = retransmissions
+	c.timeoutBackoff = backoffMultiplier
+}
+
+// LocalAddress returns the local address used by the client, also advertised as Node ID.
+func (c *PFCPClient) LocalAddress() string {
+	return c.localAddr
+}
+
+// SetSequenceWraparoundHandler sets a function called whenever the sequence number wraps around
+// from MaxSequenceNumber to 0, e.g. to log it during long-running tests.
+func (c *PFCPClient) SetSequenceWraparoundHandler(handler func()) {
 	c.seqNumLock.Lock()
 	defer c.seqNumLock.Unlock()
 
-	c.sequenceNumber++
+	c.onSequenceWraparound = handler
+}
+
+// getNextSequenceNumber returns the next sequence number, modulo 2^24.
+func (c *PFCPClient) getNextSequenceNumber() uint32 {
+	c.seqNumLock.Lock()
+
+	c.sequenceNumber = (c.sequenceNumber + 1) & MaxSequenceNumber
+	sequenceNumber := c.sequenceNumber
+	onWraparound := c.onSequenceWraparound
+
+	c.seqNumLock.Unlock()
 
-	return c.sequenceNumber
+	if sequenceNumber == 0 && onWraparound != nil {
+		onWraparound()
+	}
+
+	return sequenceNumber
 }
 
 func (c *PFCPClient) getNextFSEID() uint64 {
-	c.lastFSEID++
-	return c.lastFSEID
+	return atomic.AddUint64(&c.lastFSEID, 1)
 }
 
 func (c *PFCPClient) resetSequenceNumber() {
@@ -95,12 +229,99 @@ func (c *PFCPClient) setAssociationStatus(status bool) {
 	c.isAssociationActive = status
 }
 
-func (c *PFCPClient) sendMsg(msg message.Message) error {
+func (c *PFCPClient) isLoopback() bool {
+	c.sentLock.Lock()
+	defer c.sentLock.Unlock()
+
+	return c.responder != nil
+}
+
+// encode returns msg encoded, once the IE filter is applied.
+func (c *PFCPClient) encode(msg message.Message) ([]byte, error) {
 	b := make([]byte, msg.MarshalLen())
 	if err := msg.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return c.applyIEFilter(b)
+}
+
+func (c *PFCPClient) sendMsg(msg message.Message) error {
+	b, err := c.encode(msg)
+	if err != nil {
 		return err
 	}
 
+	if msg.MessageType() != message.MsgTypeHeartbeatRequest {
+		// heartbeats are answered through a distinct channel and never retransmitted
+		c.lastRequestLock.Lock()
+		c.lastRequest = b
+		c.lastRequestLock.Unlock()
+	}
+
+	return c.send(b)
+}
+
+// transact sends req and waits for its response, the message carrying the same sequence number.
+// Responses are routed to the transaction awaiting them, so that transactions can run concurrently.
+// Unanswered requests are retransmitted according to the retransmission policy (see SetRetransmissionPolicy).
+// Waiting stops as soon as ctx is done, e.g. to bound the duration of an operation spanning multiple requests.
+func (c *PFCPClient) transact(ctx context.Context, req message.Message) (message.Message, error) {
+	b, err := c.encode(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// registered before sending, as the response may be received before send returns
+	respChan := make(chan message.Message, 1)
+
+	c.pendingLock.Lock()
+	c.pendingRequests[req.Sequence()] = respChan
+	c.pendingLock.Unlock()
+
+	defer func() {
+		c.pendingLock.Lock()
+		delete(c.pendingRequests, req.Sequence())
+		c.pendingLock.Unlock()
+	}()
+
+	if err := c.send(b); err != nil {
+		return nil, err
+	}
+
+	timeout := c.responseTimeout
+
+	for attempt := 0; ; attempt++ {
+		timer := time.NewTimer(timeout)
+
+		select {
+		case resp := <-respChan:
+			timer.Stop()
+			return resp, nil
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, NewTimeoutExpiredError(ctx.Err())
+		case <-timer.C:
+		}
+
+		if attempt >= c.retransmissions {
+			return nil, NewTimeoutExpiredError()
+		}
+
+		if err := c.send(b); err != nil {
+			return nil, err
+		}
+
+		timeout = time.Duration(float64(timeout) * c.timeoutBackoff)
+	}
+}
+
+// send sends b, an encoded message, to the peer.
+func (c *PFCPClient) send(b []byte) error {
+	if c.isLoopback() {
+		return c.sendToLoopback(b)
+	}
+
 	if _, err := c.conn.Write(b); err != nil {
 		return err
 	}
@@ -108,11 +329,28 @@ func (c *PFCPClient) sendMsg(msg message.Message) error {
 	return nil
 }
 
+// retransmitLastRequest sends again the last request, with the same sequence number.
+func (c *PFCPClient) retransmitLastRequest() error {
+	c.lastRequestLock.Lock()
+	b := c.lastRequest
+	c.lastRequestLock.Unlock()
+
+	if b == nil {
+		return NewTimeoutExpiredError()
+	}
+
+	return c.send(b)
+}
+
 func (c *PFCPClient) receiveFromN4() {
-	buf := make([]byte, 1500)
+	buf := make([]byte, c.getMTU())
 
 	for {
 		n, _, err := c.conn.ReadFrom(buf)
+		if errors.Is(err, net.ErrClosed) {
+			return
+		}
+
 		if err != nil {
 			continue
 		}
@@ -122,14 +360,40 @@ func (c *PFCPClient) receiveFromN4() {
 			continue
 		}
 
-		switch msg := msg.(type) {
-		case *message.HeartbeatResponse:
-			c.heartbeatsChan <- msg
+		c.dispatch(msg)
+	}
+}
+
+// dispatch forwards a message received from the peer to the transaction awaiting it, if any, or to the channel
+// of its type, without blocking: the message is dropped if nobody reads the channel and its buffer is full.
+func (c *PFCPClient) dispatch(msg message.Message) {
+	switch msg := msg.(type) {
+	case *message.HeartbeatResponse:
+		select {
+		case c.heartbeatsChan <- msg:
+		default:
+		}
 
-		case *message.SessionReportRequest:
-			// Ignore message
+	case *message.SessionReportRequest:
+		// Ignore message
+	default:
+		c.pendingLock.Lock()
+		respChan, ok := c.pendingRequests[msg.Sequence()]
+		c.pendingLock.Unlock()
+
+		if ok {
+			// a duplicate response, e.g. to a retransmitted request, is dropped
+			select {
+			case respChan <- msg:
+			default:
+			}
+
+			return
+		}
+
+		select {
+		case c.recvChan <- msg:
 		default:
-			c.recvChan <- msg
 		}
 	}
 }
@@ -147,13 +411,30 @@ func (c *PFCPClient) ConnectN4(remoteAddr string) error {
 		return err
 	}
 
-	conn, err := net.DialUDP("udp", nil, raddr)
+	laddr, err := c.getBindAddress()
 	if err != nil {
 		return err
 	}
 
+	conn, err := net.DialUDP("udp", laddr, raddr)
+	if err != nil {
+		return err
+	}
+
+	if err := c.applySocketOptions(conn); err != nil {
+		conn.Close()
+		return err
+	}
+
 	c.conn = conn
 
+	if err := c.verifySourceAddress(); err != nil {
+		conn.Close()
+		c.conn = nil
+
+		return err
+	}
+
 	go c.receiveFromN4()
 
 	return nil
@@ -164,6 +445,11 @@ func (c *PFCPClient) DisconnectN4() {
 		c.cancelHeartbeats()
 	}
 
+	if c.isLoopback() {
+		c.disconnectLoopback()
+		return
+	}
+
 	c.conn.Close()
 }
 
@@ -179,45 +465,78 @@ func (c *PFCPClient) PeekNextHeartbeatResponse() (*message.HeartbeatResponse, er
 // PeekNextResponse can be used to wait for a next PFCP message from a peer.
 // It's a blocking operation, which is timed out after c.responseTimeout period (5 seconds by default).
 // Use SetPFCPResponseTimeout() to configure a custom timeout.
+// If a retransmission policy is set (see SetRetransmissionPolicy), the last request is retransmitted
+// on timeout, and the response is waited for again.
 func (c *PFCPClient) PeekNextResponse() (message.Message, error) {
-	select {
-	case msg := <-c.recvChan:
-		return msg, nil
-	case <-time.After(c.responseTimeout):
-		return nil, NewTimeoutExpiredError()
+	timeout := c.responseTimeout
+
+	for attempt := 0; ; attempt++ {
+		select {
+		case msg := <-c.recvChan:
+			return msg, nil
+		case <-time.After(timeout):
+		}
+
+		if attempt >= c.retransmissions {
+			return nil, NewTimeoutExpiredError()
+		}
+
+		if err := c.retransmitLastRequest(); err != nil {
+			return nil, err
+		}
+
+		timeout = time.Duration(float64(timeout) * c.timeoutBackoff)
 	}
 }
 
 func (c *PFCPClient) SendAssociationSetupRequest(ie ...*ieLib.IE) error {
+	return c.sendMsg(c.newAssociationSetupRequest(ie...))
+}
+
+// newAssociationSetupRequest returns a PFCP Association Setup Request, restarting the sequence numbers.
+func (c *PFCPClient) newAssociationSetupRequest(ie ...*ieLib.IE) *message.AssociationSetupRequest {
 	c.resetSequenceNumber()
 
 	assocReq := message.NewAssociationSetupRequest(
 		c.getNextSequenceNumber(),
-		ieLib.NewRecoveryTimeStamp(time.Now()),
+		ieLib.NewRecoveryTimeStamp(c.RecoveryTimeStamp()),
 		ieLib.NewNodeID(c.localAddr, "", ""),
 	)
 
+	if c.nodeType != NodeTypeUnspecified {
+		assocReq.CPFunctionFeatures = ieLib.NewCPFunctionFeatures(c.nodeType.CPFunctionFeatures())
+	}
+
 	assocReq.IEs = append(assocReq.IEs, ie...)
 
-	return c.sendMsg(assocReq)
+	return assocReq
 }
 
 // SendAssociationTeardownRequest sends PFCP Teardown Request towards a peer.
 // A caller should make sure that the PFCP connection is established before invoking this function.
 func (c *PFCPClient) SendAssociationTeardownRequest(ie ...*ieLib.IE) error {
-	teardownReq := message.NewAssociationReleaseRequest(0,
-		ieLib.NewNodeID(c.conn.RemoteAddr().String(), "", ""),
+	return c.sendMsg(c.newAssociationReleaseRequest(ie...))
+}
+
+func (c *PFCPClient) newAssociationReleaseRequest(ie ...*ieLib.IE) *message.AssociationReleaseRequest {
+	remoteAddr := LoopbackAddress
+	if !c.isLoopback() {
+		remoteAddr = c.conn.RemoteAddr().String()
+	}
+
+	teardownReq := message.NewAssociationReleaseRequest(c.getNextSequenceNumber(),
+		ieLib.NewNodeID(remoteAddr, "", ""),
 	)
 
 	teardownReq.IEs = append(teardownReq.IEs, ie...)
 
-	return c.sendMsg(teardownReq)
+	return teardownReq
 }
 
 func (c *PFCPClient) SendHeartbeatRequest() error {
 	hbReq := message.NewHeartbeatRequest(
 		c.getNextSequenceNumber(),
-		ieLib.NewRecoveryTimeStamp(time.Now()),
+		ieLib.NewRecoveryTimeStamp(c.RecoveryTimeStamp()),
 		ieLib.NewSourceIPAddress(net.ParseIP(c.localAddr), nil, 0),
 	)
 
@@ -225,50 +544,75 @@ func (c *PFCPClient) SendHeartbeatRequest() error {
 }
 
 func (c *PFCPClient) SendSessionEstablishmentRequest(pdrs []*ieLib.IE, fars []*ieLib.IE, qers []*ieLib.IE) error {
+	return c.SendSessionEstablishmentRequestWithCPAddress(c.localAddr, pdrs, fars, qers)
+}
+
+// SendSessionEstablishmentRequestWithCPAddress sends PFCP Session Establishment Request advertising
+// cpAddress (either IPv4 or IPv6) as CP F-SEID address, instead of the local address.
+// Any additional IE (e.g. Create URR) is placed in the message according to its type.
+func (c *PFCPClient) SendSessionEstablishmentRequestWithCPAddress(cpAddress string, pdrs []*ieLib.IE, fars []*ieLib.IE, qers []*ieLib.IE, ie ...*ieLib.IE) error {
+	return c.sendMsg(c.newSessionEstablishmentRequest(c.getNextFSEID(), cpAddress, pdrs, fars, qers, ie...))
+}
+
+func (c *PFCPClient) newSessionEstablishmentRequest(localSEID uint64, cpAddress string, pdrs []*ieLib.IE, fars []*ieLib.IE, qers []*ieLib.IE,
+	ie ...*ieLib.IE) *message.SessionEstablishmentRequest {
+	ies := []*ieLib.IE{
+		ieLib.NewNodeID(c.localAddr, "", ""),
+		newFSEID(localSEID, cpAddress),
+		ieLib.NewPDNType(ieLib.PDNTypeIPv4),
+	}
+
 	estReq := message.NewSessionEstablishmentRequest(
 		0,
 		0,
 		0,
 		c.getNextSequenceNumber(),
 		0,
-		ieLib.NewNodeID(c.localAddr, "", ""),
-		ieLib.NewFSEID(c.getNextFSEID(), net.ParseIP(c.localAddr), nil),
-		ieLib.NewPDNType(ieLib.PDNTypeIPv4),
+		append(ies, ie...)...,
 	)
 	estReq.CreatePDR = append(estReq.CreatePDR, pdrs...)
 	estReq.CreateFAR = append(estReq.CreateFAR, fars...)
 	estReq.CreateQER = append(estReq.CreateQER, qers...)
 
-	return c.sendMsg(estReq)
+	return estReq
+}
+
+// SendSessionModificationRequest sends PFCP Session Modification Request updating the given rules.
+// Any additional IE (e.g. Update URR) is placed in the message according to its type.
+func (c *PFCPClient) SendSessionModificationRequest(PeerSEID uint64, pdrs []*ieLib.IE, qers []*ieLib.IE, fars []*ieLib.IE, ie ...*ieLib.IE) error {
+	return c.sendMsg(c.newSessionModificationRequest(PeerSEID, pdrs, qers, fars, ie...))
 }
 
-func (c *PFCPClient) SendSessionModificationRequest(PeerSEID uint64, pdrs []*ieLib.IE, qers []*ieLib.IE, fars []*ieLib.IE) error {
+func (c *PFCPClient) newSessionModificationRequest(PeerSEID uint64, pdrs []*ieLib.IE, qers []*ieLib.IE, fars []*ieLib.IE, ie ...*ieLib.IE) *message.SessionModificationRequest {
 	modifyReq := message.NewSessionModificationRequest(
 		0,
 		0,
 		PeerSEID,
 		c.getNextSequenceNumber(),
 		0,
+		ie...,
 	)
 
 	modifyReq.UpdatePDR = append(modifyReq.UpdatePDR, pdrs...)
 	modifyReq.UpdateFAR = append(modifyReq.UpdateFAR, fars...)
 	modifyReq.UpdateQER = append(modifyReq.UpdateQER, qers...)
 
-	return c.sendMsg(modifyReq)
+	return modifyReq
 }
 
 func (c *PFCPClient) SendSessionDeletionRequest(localSEID uint64, remoteSEID uint64) error {
-	delReq := message.NewSessionDeletionRequest(
+	return c.sendMsg(c.newSessionDeletionRequest(localSEID, remoteSEID, c.localAddr))
+}
+
+func (c *PFCPClient) newSessionDeletionRequest(localSEID uint64, remoteSEID uint64, cpAddress string) *message.SessionDeletionRequest {
+	return message.NewSessionDeletionRequest(
 		0,
 		0,
 		remoteSEID,
 		c.getNextSequenceNumber(),
 		0,
-		ieLib.NewFSEID(localSEID, net.ParseIP(c.localAddr), nil),
+		newFSEID(localSEID, cpAddress),
 	)
-
-	return c.sendMsg(delReq)
 }
 
 func (c *PFCPClient) StartHeartbeats(stopCtx context.Context) {
@@ -305,40 +649,63 @@ func (c *PFCPClient) SendAndRecvHeartbeat() error {
 }
 
 // SetupAssociation sends PFCP Association Setup Request and waits for PFCP Association Setup Response.
-// Returns error if the process fails at any stage.
+// Returns error if the process fails at any stage. If the peer rejects the association with a retryable cause,
+// the request is sent again according to the retry policy (see SetAssociationRetryPolicy).
 func (c *PFCPClient) SetupAssociation() error {
-	err := c.SendAssociationSetupRequest()
-	if err != nil {
-		return err
+	return c.SetupAssociationContext(context.Background())
+}
+
+// SetupAssociationContext works as SetupAssociation, but stops waiting for responses and retries once ctx is done.
+func (c *PFCPClient) SetupAssociationContext(ctx context.Context) error {
+	for attempt := 0; ; attempt++ {
+		cause, err := c.requestAssociation(ctx)
+		if err != nil {
+			return err
+		}
+
+		if cause == ieLib.CauseRequestAccepted {
+			break
+		}
+
+		if !IsRetryableCause(cause) || attempt >= c.associationRetries {
+			return NewAssociationRejectedError(cause)
+		}
+
+		select {
+		case <-time.After(c.associationRetryInterval):
+		case <-ctx.Done():
+			return NewTimeoutExpiredError(ctx.Err())
+		}
 	}
 
-	resp, err := c.PeekNextResponse()
+	heartbeatsCtx, cancelFunc := context.WithCancel(c.ctx)
+	c.cancelHeartbeats = cancelFunc
+
+	c.setAssociationStatus(true)
+
+	go c.StartHeartbeats(heartbeatsCtx)
+
+	return nil
+}
+
+// requestAssociation sends PFCP Association Setup Request and returns the cause of the received response.
+func (c *PFCPClient) requestAssociation(ctx context.Context) (uint8, error) {
+	resp, err := c.transact(ctx, c.newAssociationSetupRequest())
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	assocResp, ok := resp.(*message.AssociationSetupResponse)
 	if !ok {
-		return NewInvalidResponseError()
+		return 0, NewInvalidResponseError()
 	}
 
 	cause, err := assocResp.Cause.Cause()
 	if err != nil {
-		return NewInvalidResponseError(err)
-	}
-
-	if cause != ieLib.CauseRequestAccepted {
-		return NewInvalidResponseError()
+		return 0, NewInvalidResponseError(err)
 	}
 
-	ctx, cancelFunc := context.WithCancel(c.ctx)
-	c.cancelHeartbeats = cancelFunc
-
-	c.setAssociationStatus(true)
-
-	go c.StartHeartbeats(ctx)
-
-	return nil
+	return cause, nil
 }
 
 func (c *PFCPClient) IsAssociationAlive() bool {
@@ -351,16 +718,16 @@ func (c *PFCPClient) IsAssociationAlive() bool {
 // TeardownAssociation tears down an already established association.
 // If called while no association is established, an error is returned
 func (c *PFCPClient) TeardownAssociation() error {
+	return c.TeardownAssociationContext(context.Background())
+}
+
+// TeardownAssociationContext works as TeardownAssociation, but stops waiting for the response once ctx is done.
+func (c *PFCPClient) TeardownAssociationContext(ctx context.Context) error {
 	if !c.IsAssociationAlive() {
 		return NewAssociationInactiveError()
 	}
 
-	err := c.SendAssociationTeardownRequest()
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.PeekNextResponse()
+	resp, err := c.transact(ctx, c.newAssociationReleaseRequest())
 	if err != nil {
 		return err
 	}
@@ -381,16 +748,30 @@ func (c *PFCPClient) TeardownAssociation() error {
 // EstablishSession sends PFCP Session Establishment Request and waits for PFCP Session Establishment Response.
 // Returns a pointer to a new PFCPSession. Returns error if the process fails at any stage.
 func (c *PFCPClient) EstablishSession(pdrs []*ieLib.IE, fars []*ieLib.IE, qers []*ieLib.IE) (*PFCPSession, error) {
-	if !c.isAssociationActive {
+	return c.EstablishSessionWithCPAddress(c.localAddr, pdrs, fars, qers)
+}
+
+// EstablishSessionWithCPAddress works as EstablishSession, but uses cpAddress as CP F-SEID address.
+// It can be used to reference a CP node other than the simulator itself (e.g. a redundant SMF).
+// The same address is used when the session is deleted. Additional IEs (e.g. Create URR) can be provided through ie.
+func (c *PFCPClient) EstablishSessionWithCPAddress(cpAddress string, pdrs []*ieLib.IE, fars []*ieLib.IE, qers []*ieLib.IE, ie ...*ieLib.IE) (*PFCPSession, error) {
+	return c.EstablishSessionContext(context.Background(), cpAddress, pdrs, fars, qers, ie...)
+}
+
+// EstablishSessionContext works as EstablishSessionWithCPAddress, but stops waiting for the response once ctx is done.
+func (c *PFCPClient) EstablishSessionContext(ctx context.Context, cpAddress string, pdrs []*ieLib.IE, fars []*ieLib.IE, qers []*ieLib.IE,
+	ie ...*ieLib.IE) (*PFCPSession, error) {
+	if !c.IsAssociationAlive() {
 		return nil, NewAssociationInactiveError()
 	}
 
-	err := c.SendSessionEstablishmentRequest(pdrs, fars, qers)
-	if err != nil {
-		return nil, err
+	if net.ParseIP(cpAddress) == nil {
+		return nil, NewInvalidFormatError("CP F-SEID address")
 	}
 
-	resp, err := c.PeekNextResponse()
+	localSEID := c.getNextFSEID()
+
+	resp, err := c.transact(ctx, c.newSessionEstablishmentRequest(localSEID, cpAddress, pdrs, fars, qers, ie...))
 	if err != nil {
 		return nil, NewTimeoutExpiredError(err)
 	}
@@ -400,6 +781,8 @@ func (c *PFCPClient) EstablishSession(pdrs []*ieLib.IE, fars []*ieLib.IE, qers [
 		return nil, NewInvalidResponseError(err)
 	}
 
+	c.updateControlInfo(estResp.LoadControlInformation, estResp.OverloadControlInformation)
+
 	if cause, err := estResp.Cause.Cause(); err != nil || cause != ieLib.CauseRequestAccepted {
 		return nil, NewInvalidCauseError(err)
 	}
@@ -410,24 +793,29 @@ func (c *PFCPClient) EstablishSession(pdrs []*ieLib.IE, fars []*ieLib.IE, qers [
 	}
 
 	sess := &PFCPSession{
-		localSEID: c.lastFSEID,
-		peerSEID:  remoteSEID.SEID,
+		localSEID:   localSEID,
+		peerSEID:    remoteSEID.SEID,
+		cpAddress:   cpAddress,
+		createdPDRs: parseCreatedPDRs(estResp.CreatedPDR),
 	}
 
 	return sess, nil
 }
 
-func (c *PFCPClient) ModifySession(sess *PFCPSession, pdrs []*ieLib.IE, fars []*ieLib.IE, qers []*ieLib.IE) error {
-	if !c.isAssociationActive {
-		return NewAssociationInactiveError()
-	}
+// ModifySession sends PFCP Session Modification Request and awaits for PFCP Session Modification Response.
+// Additional IEs (e.g. Update URR) can be provided through ie.
+func (c *PFCPClient) ModifySession(sess *PFCPSession, pdrs []*ieLib.IE, fars []*ieLib.IE, qers []*ieLib.IE, ie ...*ieLib.IE) error {
+	return c.ModifySessionContext(context.Background(), sess, pdrs, fars, qers, ie...)
+}
 
-	err := c.SendSessionModificationRequest(sess.peerSEID, pdrs, fars, qers)
-	if err != nil {
-		return err
+// ModifySessionContext works as ModifySession, but stops waiting for the response once ctx is done.
+func (c *PFCPClient) ModifySessionContext(ctx context.Context, sess *PFCPSession, pdrs []*ieLib.IE, fars []*ieLib.IE, qers []*ieLib.IE,
+	ie ...*ieLib.IE) error {
+	if !c.IsAssociationAlive() {
+		return NewAssociationInactiveError()
 	}
 
-	resp, err := c.PeekNextResponse()
+	resp, err := c.transact(ctx, c.newSessionModificationRequest(sess.peerSEID, pdrs, qers, fars, ie...))
 	if err != nil {
 		return NewTimeoutExpiredError(err)
 	}
@@ -437,6 +825,8 @@ func (c *PFCPClient) ModifySession(sess *PFCPSession, pdrs []*ieLib.IE, fars []*
 		return NewInvalidResponseError(err)
 	}
 
+	c.updateControlInfo(modRes.LoadControlInformation, modRes.OverloadControlInformation)
+
 	if cause, err := modRes.Cause.Cause(); err != nil || cause != ieLib.CauseRequestAccepted {
 		return NewInvalidCauseError(err)
 	}
@@ -447,12 +837,17 @@ func (c *PFCPClient) ModifySession(sess *PFCPSession, pdrs []*ieLib.IE, fars []*
 // DeleteSession sends Session Deletion Request for each session and awaits for PFCP Session Deletion Response.
 // Returns error if the process fails at any stage.
 func (c *PFCPClient) DeleteSession(sess *PFCPSession) error {
-	err := c.SendSessionDeletionRequest(sess.localSEID, sess.peerSEID)
-	if err != nil {
-		return err
+	return c.DeleteSessionContext(context.Background(), sess)
+}
+
+// DeleteSessionContext works as DeleteSession, but stops waiting for the response once ctx is done.
+func (c *PFCPClient) DeleteSessionContext(ctx context.Context, sess *PFCPSession) error {
+	cpAddress := sess.cpAddress
+	if cpAddress == "" {
+		cpAddress = c.localAddr
 	}
 
-	resp, err := c.PeekNextResponse()
+	resp, err := c.transact(ctx, c.newSessionDeletionRequest(sess.localSEID, sess.peerSEID, cpAddress))
 	if err != nil {
 		return err
 	}
@@ -462,9 +857,21 @@ func (c *PFCPClient) DeleteSession(sess *PFCPSession) error {
 		return NewInvalidResponseError()
 	}
 
+	c.updateControlInfo(delResp.LoadControlInformation, delResp.OverloadControlInformation)
+
 	if cause, err := delResp.Cause.Cause(); err != nil || cause != ieLib.CauseRequestAccepted {
 		return NewInvalidCauseError(err)
 	}
 
 	return nil
 }
+
+// newFSEID returns a F-SEID IE carrying address either as IPv4 or IPv6 address.
+func newFSEID(seid uint64, address string) *ieLib.IE {
+	ip := net.ParseIP(address)
+	if ip.To4() == nil {
+		return ieLib.NewFSEID(seid, nil, ip)
+	}
+
+	return ieLib.NewFSEID(seid, ip, nil)
+}
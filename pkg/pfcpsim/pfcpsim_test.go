@@ -0,0 +1,677 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"context"
+	"net"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ieLib "github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// fakePeer emulates a remote PFCP agent. Every received request is passed to handler,
+// whose returned message (if any) is sent back to the client.
+type fakePeer struct {
+	conn     *net.UDPConn
+	handler  func(req message.Message) message.Message
+	received chan message.Message
+}
+
+func newFakePeer(t *testing.T, handler func(req message.Message) message.Message) *fakePeer {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.ParseIP("127.0.0.1")})
+	require.NoError(t, err)
+
+	peer := &fakePeer{
+		conn:     conn,
+		handler:  handler,
+		received: make(chan message.Message, 100),
+	}
+
+	t.Cleanup(func() { conn.Close() })
+
+	go peer.serve()
+
+	return peer
+}
+
+func (p *fakePeer) address() string {
+	return p.conn.LocalAddr().String()
+}
+
+func (p *fakePeer) serve() {
+	buf := make([]byte, 1500)
+
+	for {
+		n, raddr, err := p.conn.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+
+		req, err := message.Parse(buf[:n])
+		if err != nil {
+			continue
+		}
+
+		p.received <- req
+
+		resp := p.handler(req)
+		if resp == nil {
+			continue
+		}
+
+		b := make([]byte, resp.MarshalLen())
+		if err := resp.MarshalTo(b); err != nil {
+			continue
+		}
+
+		_, _ = p.conn.WriteToUDP(b, raddr)
+	}
+}
+
+// nextReceived returns the next request received by the peer with the given message type,
+// skipping any other message (e.g. heartbeats).
+func (p *fakePeer) nextReceived(t *testing.T, msgType uint8) message.Message {
+	for {
+		select {
+		case msg := <-p.received:
+			if msg.MessageType() == msgType {
+				return msg
+			}
+		case <-time.After(2 * time.Second):
+			require.FailNow(t, "no message received by fake peer")
+		}
+	}
+}
+
+// newAssociatedClient returns a PFCPClient connected and associated to peer.
+func newAssociatedClient(t *testing.T, peer *fakePeer) *PFCPClient {
+	client := NewPFCPClient("127.0.0.1")
+	client.SetPFCPResponseTimeout(time.Second)
+
+	require.NoError(t, client.ConnectN4(peer.address()))
+	t.Cleanup(client.DisconnectN4)
+
+	require.NoError(t, client.SetupAssociation())
+	peer.nextReceived(t, message.MsgTypeAssociationSetupRequest)
+
+	return client
+}
+
+func TestEstablishSessionWithCPAddress(t *testing.T) {
+	peer := newFakePeer(t, AcceptAllResponder)
+	client := newAssociatedClient(t, peer)
+
+	sess, err := client.EstablishSessionWithCPAddress("10.0.0.2", nil, nil, nil)
+	require.NoError(t, err)
+
+	estReq := peer.nextReceived(t, message.MsgTypeSessionEstablishmentRequest).(*message.SessionEstablishmentRequest)
+
+	fseid, err := estReq.CPFSEID.FSEID()
+	require.NoError(t, err)
+	require.True(t, fseid.IPv4Address.Equal(net.ParseIP("10.0.0.2")))
+
+	nodeID, err := estReq.NodeID.NodeID()
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1", nodeID)
+
+	// The same CP address is expected to be used while deleting the session
+	require.NoError(t, client.DeleteSession(sess))
+
+	delReq := peer.nextReceived(t, message.MsgTypeSessionDeletionRequest).(*message.SessionDeletionRequest)
+
+	require.Len(t, delReq.IEs, 1)
+
+	fseid, err = delReq.IEs[0].FSEID()
+	require.NoError(t, err)
+	require.True(t, fseid.IPv4Address.Equal(net.ParseIP("10.0.0.2")))
+}
+
+func TestEstablishSessionWithInvalidCPAddress(t *testing.T) {
+	peer := newFakePeer(t, AcceptAllResponder)
+	client := newAssociatedClient(t, peer)
+
+	_, err := client.EstablishSessionWithCPAddress("not-an-address", nil, nil, nil)
+	require.Error(t, err)
+}
+
+func TestEstablishSessionWithAdditionalIEs(t *testing.T) {
+	peer := newFakePeer(t, AcceptAllResponder)
+	client := newAssociatedClient(t, peer)
+
+	urr := ieLib.NewCreateURR(
+		ieLib.NewURRID(1),
+		ieLib.NewMeasurementMethod(0, 1, 1),
+		ieLib.NewReportingTriggers(0x0200),
+	)
+
+	_, err := client.EstablishSessionWithCPAddress("127.0.0.1", nil, nil, nil, urr)
+	require.NoError(t, err)
+
+	estReq := peer.nextReceived(t, message.MsgTypeSessionEstablishmentRequest).(*message.SessionEstablishmentRequest)
+	require.Len(t, estReq.CreateURR, 1)
+
+	urrID, err := estReq.CreateURR[0].URRID()
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), urrID)
+}
+
+func TestConnectLoopback(t *testing.T) {
+	client := NewPFCPClient("127.0.0.1")
+	client.SetPFCPResponseTimeout(time.Second)
+	client.ConnectLoopback(AcceptAllResponder)
+
+	require.NoError(t, client.SetupAssociation())
+
+	sess, err := client.EstablishSession(nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, client.ModifySession(sess, nil, nil, nil))
+	require.NoError(t, client.DeleteSession(sess))
+	require.NoError(t, client.TeardownAssociation())
+
+	client.DisconnectN4()
+
+	var sentTypes []uint8
+	for _, msg := range client.SentMessages() {
+		sentTypes = append(sentTypes, msg.MessageType())
+	}
+
+	require.Equal(t, []uint8{
+		message.MsgTypeAssociationSetupRequest,
+		message.MsgTypeSessionEstablishmentRequest,
+		message.MsgTypeSessionModificationRequest,
+		message.MsgTypeSessionDeletionRequest,
+		message.MsgTypeAssociationReleaseRequest,
+	}, sentTypes)
+}
+
+func TestLoopbackUnreadResponses(t *testing.T) {
+	client := NewPFCPClient("127.0.0.1")
+	client.ConnectLoopback(AcceptAllResponder)
+
+	goroutines := runtime.NumGoroutine()
+
+	// the responses are never read: they must neither block the client nor leak goroutines
+	for i := 0; i < 2*receiveBufferSize; i++ {
+		require.NoError(t, client.SendHeartbeatRequest())
+		require.NoError(t, client.SendSessionEstablishmentRequest(nil, nil, nil))
+	}
+
+	require.LessOrEqual(t, runtime.NumGoroutine(), goroutines)
+}
+
+func TestConcurrentTransactions(t *testing.T) {
+	client := NewPFCPClient("127.0.0.1")
+	client.SetPFCPResponseTimeout(time.Second)
+
+	var (
+		lock          sync.Mutex
+		modifications []message.Message
+	)
+
+	// modifications are answered in reverse order, once both are received
+	client.ConnectLoopback(func(req message.Message) message.Message {
+		if req.MessageType() != message.MsgTypeSessionModificationRequest {
+			return AcceptAllResponder(req)
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		modifications = append(modifications, req)
+
+		if len(modifications) == 2 {
+			client.dispatch(AcceptAllResponder(modifications[1]))
+			client.dispatch(AcceptAllResponder(modifications[0]))
+		}
+
+		return nil
+	})
+
+	require.NoError(t, client.SetupAssociation())
+
+	var wg sync.WaitGroup
+
+	errs := make([]error, 2)
+
+	for i := range errs {
+		sess, err := client.EstablishSession(nil, nil, nil)
+		require.NoError(t, err)
+
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = client.ModifySession(sess, nil, nil, nil)
+		}(i)
+	}
+
+	wg.Wait()
+
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+}
+
+func TestTransactionContext(t *testing.T) {
+	client := NewPFCPClient("127.0.0.1")
+	client.SetPFCPResponseTimeout(time.Second)
+
+	// modifications are never answered, deletions are answered after a delay
+	client.ConnectLoopback(func(req message.Message) message.Message {
+		switch req.MessageType() {
+		case message.MsgTypeSessionModificationRequest:
+			return nil
+		case message.MsgTypeSessionDeletionRequest:
+			go func() {
+				time.Sleep(200 * time.Millisecond)
+				client.dispatch(AcceptAllResponder(req))
+			}()
+
+			return nil
+		default:
+			return AcceptAllResponder(req)
+		}
+	})
+
+	require.NoError(t, client.SetupAssociation())
+
+	sess, err := client.EstablishSession(nil, nil, nil)
+	require.NoError(t, err)
+
+	var (
+		wg        sync.WaitGroup
+		deleteErr error
+	)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		deleteErr = client.DeleteSession(sess)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = client.ModifySessionContext(ctx, sess, nil, nil, nil)
+	require.Error(t, err)
+	require.Less(t, int64(time.Since(start)), int64(time.Second), "the context deadline must bound the wait")
+
+	// the deadline of the modification does not affect the concurrent deletion
+	wg.Wait()
+	require.NoError(t, deleteErr)
+}
+
+// rejectAssociationResponder returns a responder rejecting the given number of association setup requests with cause,
+// then accepting every request.
+func rejectAssociationResponder(cause uint8, rejections int) func(req message.Message) message.Message {
+	var lock sync.Mutex
+
+	return func(req message.Message) message.Message {
+		if req.MessageType() != message.MsgTypeAssociationSetupRequest {
+			return AcceptAllResponder(req)
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		if rejections == 0 {
+			return AcceptAllResponder(req)
+		}
+
+		rejections--
+
+		return message.NewAssociationSetupResponse(req.Sequence(),
+			ieLib.NewNodeID("127.0.0.1", "", ""), ieLib.NewCause(cause), ieLib.NewRecoveryTimeStamp(time.Now()))
+	}
+}
+
+func TestSetupAssociationRejected(t *testing.T) {
+	peer := newFakePeer(t, rejectAssociationResponder(ieLib.CauseNoEstablishedPFCPAssociation, 1))
+
+	client := NewPFCPClient("127.0.0.1")
+	client.SetPFCPResponseTimeout(time.Second)
+	client.SetAssociationRetryPolicy(3, 10*time.Millisecond)
+
+	require.NoError(t, client.ConnectN4(peer.address()))
+	t.Cleanup(client.DisconnectN4)
+
+	err := client.SetupAssociation()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "No established PFCP Association (72)")
+	require.False(t, client.IsAssociationAlive())
+
+	// Non-retryable causes are expected to fail at the first attempt
+	peer.nextReceived(t, message.MsgTypeAssociationSetupRequest)
+	require.Len(t, peer.received, 0)
+}
+
+func TestSetupAssociationRetryableCause(t *testing.T) {
+	peer := newFakePeer(t, rejectAssociationResponder(ieLib.CausePFCPEntityInCongestion, 2))
+
+	client := NewPFCPClient("127.0.0.1")
+	client.SetPFCPResponseTimeout(time.Second)
+	client.SetAssociationRetryPolicy(2, 10*time.Millisecond)
+
+	require.NoError(t, client.ConnectN4(peer.address()))
+	t.Cleanup(client.DisconnectN4)
+
+	require.NoError(t, client.SetupAssociation())
+	require.True(t, client.IsAssociationAlive())
+
+	for i := 0; i < 3; i++ {
+		peer.nextReceived(t, message.MsgTypeAssociationSetupRequest)
+	}
+
+}
+
+func TestSetupAssociationRetriesExhausted(t *testing.T) {
+	peer := newFakePeer(t, rejectAssociationResponder(ieLib.CausePFCPEntityInCongestion, 3))
+
+	client := NewPFCPClient("127.0.0.1")
+	client.SetPFCPResponseTimeout(time.Second)
+	client.SetAssociationRetryPolicy(1, 10*time.Millisecond)
+
+	require.NoError(t, client.ConnectN4(peer.address()))
+	t.Cleanup(client.DisconnectN4)
+
+	err := client.SetupAssociation()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "PFCP entity in congestion (74)")
+}
+
+func TestSetupAssociationNoRetryByDefault(t *testing.T) {
+	peer := newFakePeer(t, rejectAssociationResponder(ieLib.CausePFCPEntityInCongestion, 1))
+
+	client := NewPFCPClient("127.0.0.1")
+	client.SetPFCPResponseTimeout(time.Second)
+
+	require.NoError(t, client.ConnectN4(peer.address()))
+	t.Cleanup(client.DisconnectN4)
+
+	require.Error(t, client.SetupAssociation())
+	require.False(t, client.IsAssociationAlive())
+}
+
+// controlInfoResponder emulates a peer accepting every request and advertising load and overload
+// through the Session Establishment Responses, using seq as sequence number.
+func controlInfoResponder(seq uint32, loadMetric uint8, reductionMetric uint8, validity time.Duration) Responder {
+	return func(req message.Message) message.Message {
+		resp := AcceptAllResponder(req)
+
+		if estResp, ok := resp.(*message.SessionEstablishmentResponse); ok {
+			estResp.LoadControlInformation = ieLib.NewLoadControlInformation(
+				ieLib.NewSequenceNumber(seq),
+				ieLib.NewMetric(loadMetric),
+			)
+			estResp.OverloadControlInformation = ieLib.NewOverloadControlInformation(
+				ieLib.NewSequenceNumber(seq),
+				ieLib.NewMetric(reductionMetric),
+				ieLib.NewTimer(validity),
+			)
+		}
+
+		return resp
+	}
+}
+
+func TestLoadAndOverloadControl(t *testing.T) {
+	peer := newFakePeer(t, controlInfoResponder(2, 80, 50, 10*time.Second))
+	client := newAssociatedClient(t, peer)
+
+	require.True(t, client.LoadControl().ReceivedAt.IsZero())
+	require.False(t, client.OverloadControl().IsActive())
+
+	_, err := client.EstablishSession(nil, nil, nil)
+	require.NoError(t, err)
+
+	load := client.LoadControl()
+	require.Equal(t, uint32(2), load.SequenceNumber)
+	require.Equal(t, uint8(80), load.Metric)
+
+	overload := client.OverloadControl()
+	require.Equal(t, uint32(2), overload.SequenceNumber)
+	require.Equal(t, uint8(50), overload.ReductionMetric)
+	require.Equal(t, 10*time.Second, overload.Validity)
+	require.True(t, overload.IsActive())
+
+	// information not newer than the stored one is discarded
+	client.updateControlInfo(
+		ieLib.NewLoadControlInformation(ieLib.NewSequenceNumber(1), ieLib.NewMetric(10)),
+		ieLib.NewOverloadControlInformation(ieLib.NewSequenceNumber(2), ieLib.NewMetric(0), ieLib.NewTimer(0)),
+	)
+
+	require.Equal(t, load, client.LoadControl())
+	require.Equal(t, overload, client.OverloadControl())
+}
+
+func TestIEFilter(t *testing.T) {
+	pdr := ieLib.NewCreatePDR(
+		ieLib.NewPDRID(1),
+		ieLib.NewPrecedence(100),
+		ieLib.NewPDI(
+			ieLib.NewSourceInterface(ieLib.SrcInterfaceCore),
+			ieLib.NewNetworkInstanceFQDN("internet"),
+			ieLib.NewSDFFilter("permit out ip from any to assigned", "", "", "", 1),
+		),
+		ieLib.NewOuterHeaderRemoval(0, 0),
+		ieLib.NewFARID(1),
+	)
+
+	filter := IEFilter{Include: []uint16{ieLib.SDFFilter}}
+
+	filtered := filter.filterIEs([]*ieLib.IE{pdr})
+	require.Len(t, filtered, 1)
+
+	// mandatory IEs are kept, along with the included optional ones
+	var types []uint16
+	for _, ie := range filtered[0].ChildIEs {
+		types = append(types, ie.Type)
+	}
+
+	require.Equal(t, []uint16{ieLib.PDRID, ieLib.Precedence, ieLib.PDI, ieLib.FARID}, types)
+
+	pdi, err := filtered[0].PDI()
+	require.NoError(t, err)
+	require.Len(t, pdi, 2)
+	require.Equal(t, ieLib.SDFFilter, pdi[1].Type)
+
+	// excluded IEs are omitted even if mandatory or included
+	filter.Exclude = []uint16{ieLib.SDFFilter, ieLib.Precedence}
+
+	filtered = filter.filterIEs([]*ieLib.IE{pdr})
+
+	_, err = filtered[0].Precedence()
+	require.ErrorIs(t, err, ieLib.ErrIENotFound)
+
+	pdi, err = filtered[0].PDI()
+	require.NoError(t, err)
+	require.Len(t, pdi, 1)
+}
+
+func TestRetransmissionBackoff(t *testing.T) {
+	var (
+		lock       sync.Mutex
+		receivedAt []time.Time
+	)
+
+	// the peer never answers session establishment requests
+	peer := newFakePeer(t, func(req message.Message) message.Message {
+		if req.MessageType() == message.MsgTypeSessionEstablishmentRequest {
+			lock.Lock()
+			receivedAt = append(receivedAt, time.Now())
+			lock.Unlock()
+
+			return nil
+		}
+
+		return AcceptAllResponder(req)
+	})
+
+	client := newAssociatedClient(t, peer)
+
+	timeout := 50 * time.Millisecond
+	client.SetPFCPResponseTimeout(timeout)
+	client.SetRetransmissionPolicy(3, 2)
+
+	_, err := client.EstablishSession(nil, nil, nil)
+	require.Error(t, err)
+
+	// retransmissions reuse the sequence number of the request
+	seq := peer.nextReceived(t, message.MsgTypeSessionEstablishmentRequest).Sequence()
+	for i := 0; i < 3; i++ {
+		require.Equal(t, seq, peer.nextReceived(t, message.MsgTypeSessionEstablishmentRequest).Sequence())
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	require.Len(t, receivedAt, 4)
+
+	for i := 1; i < len(receivedAt); i++ {
+		// the time waited for a response doubles after each retransmission
+		require.GreaterOrEqual(t, receivedAt[i].Sub(receivedAt[i-1]), timeout)
+		timeout *= 2
+	}
+
+	require.Greater(t, receivedAt[3].Sub(receivedAt[2]), receivedAt[2].Sub(receivedAt[1]))
+	require.Greater(t, receivedAt[2].Sub(receivedAt[1]), receivedAt[1].Sub(receivedAt[0]))
+}
+
+func TestBumpRecoveryTimeStamp(t *testing.T) {
+	client := NewPFCPClient("127.0.0.1")
+	client.SetPFCPResponseTimeout(time.Second)
+	client.ConnectLoopback(AcceptAllResponder)
+
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	client.SetRecoveryTimeStamp(startTime)
+
+	require.NoError(t, client.SetupAssociation())
+	require.NoError(t, client.SendHeartbeatRequest())
+
+	bumped := client.BumpRecoveryTimeStamp()
+	require.True(t, bumped.After(startTime))
+
+	require.NoError(t, client.SendHeartbeatRequest())
+
+	var recoveryTimeStamps []time.Time
+
+	for _, msg := range client.SentMessages() {
+		switch msg := msg.(type) {
+		case *message.AssociationSetupRequest:
+			ts, err := msg.RecoveryTimeStamp.RecoveryTimeStamp()
+			require.NoError(t, err)
+
+			recoveryTimeStamps = append(recoveryTimeStamps, ts)
+		case *message.HeartbeatRequest:
+			ts, err := msg.RecoveryTimeStamp.RecoveryTimeStamp()
+			require.NoError(t, err)
+
+			recoveryTimeStamps = append(recoveryTimeStamps, ts)
+		}
+	}
+
+	require.Len(t, recoveryTimeStamps, 3)
+	require.True(t, startTime.Equal(recoveryTimeStamps[0]))
+	require.True(t, startTime.Equal(recoveryTimeStamps[1]))
+	// the next heartbeat advertises the restart
+	require.True(t, bumped.Equal(recoveryTimeStamps[2]))
+
+	// bumping again in the same second still advances the time stamp
+	require.True(t, client.BumpRecoveryTimeStamp().After(bumped))
+}
+
+func TestSequenceNumberWraparound(t *testing.T) {
+	client := NewPFCPClient("127.0.0.1")
+	client.SetPFCPResponseTimeout(time.Second)
+
+	var wraparounds int
+
+	client.SetSequenceWraparoundHandler(func() { wraparounds++ })
+
+	// every modification is also answered by a late rejection, carrying the sequence number of the previous request
+	client.ConnectLoopback(func(req message.Message) message.Message {
+		if req.MessageType() == message.MsgTypeSessionModificationRequest {
+			previous := (req.Sequence() - 1) & MaxSequenceNumber
+
+			go client.dispatch(message.NewSessionModificationResponse(0, 0, req.SEID(), previous, 0,
+				ieLib.NewCause(ieLib.CauseRequestRejected)))
+		}
+
+		return AcceptAllResponder(req)
+	})
+
+	require.NoError(t, client.SetupAssociation())
+
+	sess, err := client.EstablishSession(nil, nil, nil)
+	require.NoError(t, err)
+
+	// drive the sequence number next to the wrap boundary
+	client.sequenceNumber = MaxSequenceNumber - 1
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, client.ModifySession(sess, nil, nil, nil))
+	}
+
+	require.NoError(t, client.DeleteSession(sess))
+
+	var sequenceNumbers []uint32
+
+	for _, msg := range client.SentMessages() {
+		switch msg.MessageType() {
+		case message.MsgTypeSessionModificationRequest, message.MsgTypeSessionDeletionRequest:
+			sequenceNumbers = append(sequenceNumbers, msg.Sequence())
+		}
+	}
+
+	require.Equal(t, []uint32{MaxSequenceNumber, 0, 1, 2}, sequenceNumbers)
+	require.Equal(t, 1, wraparounds)
+
+	// the release is held by the peer while the sequence number wraps around, and must not be answered
+	// by the response to the request sent meanwhile
+	releases := make(chan message.Message, 1)
+
+	client.ConnectLoopback(func(req message.Message) message.Message {
+		if req.MessageType() == message.MsgTypeAssociationReleaseRequest {
+			releases <- req
+			return nil
+		}
+
+		return AcceptAllResponder(req)
+	})
+
+	client.sequenceNumber = MaxSequenceNumber - 1
+
+	teardownErr := make(chan error, 1)
+
+	go func() {
+		teardownErr <- client.TeardownAssociation()
+	}()
+
+	release := <-releases
+	require.Equal(t, uint32(MaxSequenceNumber), release.Sequence())
+
+	sess, err = client.EstablishSession(nil, nil, nil)
+	require.NoError(t, err)
+
+	go client.dispatch(AcceptAllResponder(release))
+
+	require.NoError(t, <-teardownErr)
+	require.False(t, client.IsAssociationAlive())
+
+	sequenceNumbers = nil
+
+	for _, msg := range client.SentMessages() {
+		sequenceNumbers = append(sequenceNumbers, msg.Sequence())
+	}
+
+	require.Equal(t, []uint32{MaxSequenceNumber, 0}, sequenceNumbers)
+	require.Equal(t, 2, wraparounds)
+}
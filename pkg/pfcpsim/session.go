@@ -3,7 +3,95 @@
 
 package pfcpsim
 
+import (
+	"net"
+
+	ieLib "github.com/wmnsk/go-pfcp/ie"
+)
+
 type PFCPSession struct {
 	localSEID uint64
 	peerSEID  uint64
+
+	// cpAddress is the address advertised in the CP F-SEID
+	cpAddress string
+
+	// createdPDRs are the PDRs confirmed by the peer upon establishment
+	createdPDRs []CreatedPDR
+}
+
+// CreatedPDR is a PDR confirmed by the peer through a Created PDR IE, along with the resources it allocated, if any.
+type CreatedPDR struct {
+	ID uint16
+	// TEID and N3Address are the F-TEID allocated by the peer
+	TEID      uint32
+	N3Address string
+	// UEAddress is the UE IP address allocated by the peer
+	UEAddress string
+}
+
+// LocalSEID returns the SEID allocated to the session by the client.
+func (s *PFCPSession) LocalSEID() uint64 {
+	return s.localSEID
+}
+
+// PeerSEID returns the SEID allocated to the session by the peer.
+func (s *PFCPSession) PeerSEID() uint64 {
+	return s.peerSEID
+}
+
+// CreatedPDRs returns the PDRs confirmed by the peer through the Created PDR IEs of the Session Establishment Response.
+func (s *PFCPSession) CreatedPDRs() []CreatedPDR {
+	return s.createdPDRs
+}
+
+// parseCreatedPDRs returns the PDRs confirmed by the Created PDR IEs.
+// Created PDR IEs without a valid PDR ID are ignored, as are malformed F-TEID and UE IP Address IEs.
+func parseCreatedPDRs(ies []*ieLib.IE) []CreatedPDR {
+	var created []CreatedPDR
+
+	for _, x := range ies {
+		children, err := x.CreatedPDR()
+		if err != nil {
+			continue
+		}
+
+		pdr := CreatedPDR{}
+		hasID := false
+
+		for _, child := range children {
+			switch child.Type {
+			case ieLib.PDRID:
+				if pdr.ID, err = child.PDRID(); err == nil {
+					hasID = true
+				}
+			case ieLib.FTEID:
+				if fteid, err := child.FTEID(); err == nil {
+					pdr.TEID = fteid.TEID
+					pdr.N3Address = firstIP(fteid.IPv4Address, fteid.IPv6Address)
+				}
+			case ieLib.UEIPAddress:
+				if ueIP, err := child.UEIPAddress(); err == nil {
+					pdr.UEAddress = firstIP(ueIP.IPv4Address, ueIP.IPv6Address)
+				}
+			}
+		}
+
+		if hasID {
+			created = append(created, pdr)
+		}
+	}
+
+	return created
+}
+
+// firstIP returns the first of ips which is set, as a string. Returns an empty string if none is.
+func firstIP(ips ...net.IP) string {
+	for _, ip := range ips {
+		if ip != nil {
+			return ip.String()
+		}
+	}
+
+	return ""
 }
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package session
+
+import "math"
+
+// BitRateUnit is the unit of a bit rate converted through ToKbps.
+type BitRateUnit uint8
+
+const (
+	Kbps BitRateUnit = iota
+	Bps
+	Mbps
+)
+
+// MaxBitRate is the maximum bit rate, in kbps, that can be encoded in MBR and GBR IEs (40 bits).
+const MaxBitRate uint64 = 1<<40 - 1
+
+// ToKbps converts rate, expressed in unit, to kbps: the unit of MBR and GBR IEs. Refer to section 8.2.8 in PFCP specs Release 16.
+// Rates in bps are rounded up, so that a non-zero rate is never encoded as zero.
+// Returns false if the converted rate exceeds MaxBitRate.
+func ToKbps(rate uint64, unit BitRateUnit) (uint64, bool) {
+	switch unit {
+	case Bps:
+		rate = rate/1000 + uint64(boolToInt(rate%1000 != 0))
+	case Mbps:
+		if rate > math.MaxUint64/1000 {
+			return 0, false
+		}
+
+		rate *= 1000
+	}
+
+	return rate, rate <= MaxBitRate
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package session
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToKbps(t *testing.T) {
+	type testCase struct {
+		rate        uint64
+		unit        BitRateUnit
+		expected    uint64
+		expectedOK  bool
+		description string
+	}
+
+	for _, scenario := range []testCase{
+		{rate: 60000, unit: Kbps, expected: 60000, expectedOK: true, description: "kbps are not converted"},
+		{rate: 1, unit: Mbps, expected: 1000, expectedOK: true, description: "1 mbps"},
+		{rate: 128000, unit: Bps, expected: 128, expectedOK: true, description: "bps multiple of 1000"},
+		{rate: 1500, unit: Bps, expected: 2, expectedOK: true, description: "bps are rounded up"},
+		{rate: MaxBitRate, unit: Kbps, expected: MaxBitRate, expectedOK: true, description: "max bit rate"},
+		{rate: MaxBitRate + 1, unit: Kbps, expected: MaxBitRate + 1, expectedOK: false, description: "bit rate exceeding 40 bits"},
+		{rate: 1 << 62, unit: Mbps, expected: 0, expectedOK: false, description: "overflowing bit rate"},
+	} {
+		t.Run(scenario.description, func(t *testing.T) {
+			rate, ok := ToKbps(scenario.rate, scenario.unit)
+			assert.Equal(t, scenario.expected, rate)
+			assert.Equal(t, scenario.expectedOK, ok)
+		})
+	}
+}
+
+func TestMBREncodingWithUnits(t *testing.T) {
+	mbr, ok := ToKbps(1, Mbps)
+	assert.True(t, ok)
+
+	qer := NewQERBuilder().
+		WithID(1).
+		WithMethod(Create).
+		WithUplinkMBR(mbr).
+		WithDownlinkMBR(mbr).
+		Build()
+
+	// MBR IEs are encoded in kbps
+	ulMBR, err := qer.MBRUL()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1000), ulMBR)
+
+	dlMBR, err := qer.MBRDL()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1000), dlMBR)
+}
@@ -15,6 +15,13 @@ type farBuilder struct {
 	downlinkIP   string
 	dstInterface uint8
 
+	// networkInstance, if set, is the Network Instance of the forwarding parameters (see WithNetworkInstance)
+	networkInstance string
+
+	// uplinkSteeringPolicy and downlinkSteeringPolicy are the Traffic Steering Policy Identifiers (see WithTrafficSteering)
+	uplinkSteeringPolicy   string
+	downlinkSteeringPolicy string
+
 	zeroBasedOuterHeader bool
 	isActionSet          bool
 	isInterfaceSet       bool
@@ -64,6 +71,23 @@ func (b *farBuilder) WithDownlinkIP(downlinkIP string) *farBuilder {
 	return b
 }
 
+// WithNetworkInstance sets the Network Instance of the forwarding parameters, i.e. the DNN the traffic is forwarded to.
+// If not set, the Network Instance IE is omitted.
+func (b *farBuilder) WithNetworkInstance(networkInstance string) *farBuilder {
+	b.networkInstance = networkInstance
+	return b
+}
+
+// WithTrafficSteering steers the forwarded traffic through the service function chain identified by a
+// Traffic Steering Policy Identifier, encoded in the Forwarding Policy IE: up if the destination interface is Core,
+// down otherwise. An empty identifier leaves the traffic of the matching direction unsteered.
+func (b *farBuilder) WithTrafficSteering(up, down string) *farBuilder {
+	b.uplinkSteeringPolicy = up
+	b.downlinkSteeringPolicy = down
+
+	return b
+}
+
 func (b *farBuilder) validate() {
 	if b.farID == 0 {
 		panic("Tried building FAR without setting FAR ID")
@@ -80,6 +104,10 @@ func (b *farBuilder) validate() {
 	if !b.isActionSet {
 		panic("Tried building FAR without setting an action")
 	}
+
+	if len(b.uplinkSteeringPolicy) > maxForwardingPolicyIDLength || len(b.downlinkSteeringPolicy) > maxForwardingPolicyIDLength {
+		panic("Tried building FAR with a too long Traffic Steering Policy Identifier")
+	}
 }
 
 // BuildFAR returns a downlinkFAR if MarkAsDownlink was invoked.
@@ -99,6 +127,10 @@ func (b *farBuilder) BuildFAR() *ie.IE {
 		)
 	}
 
+	if b.networkInstance != "" {
+		fwdParams.Add(ie.NewNetworkInstanceFQDN(b.networkInstance))
+	}
+
 	if b.zeroBasedOuterHeader {
 		fwdParams.Add(ie.NewOuterHeaderCreation(S_TAG, 0, "0.0.0.0", "", 0, 0, 0))
 	} else if b.downlinkIP != "" { //TODO revisit code and improve its structure
@@ -106,6 +138,10 @@ func (b *farBuilder) BuildFAR() *ie.IE {
 		fwdParams.Add(ie.NewOuterHeaderCreation(S_TAG, b.teid, b.downlinkIP, "", 0, 0, 0))
 	}
 
+	if policy := b.trafficSteeringPolicy(); policy != "" {
+		fwdParams.Add(ie.NewForwardingPolicy(policy))
+	}
+
 	far := createFunc(
 		ie.NewFARID(b.farID),
 		ie.NewApplyAction(b.applyAction),
@@ -118,3 +154,12 @@ func (b *farBuilder) BuildFAR() *ie.IE {
 
 	return far
 }
+
+// trafficSteeringPolicy returns the Traffic Steering Policy Identifier of the direction of the FAR.
+func (b *farBuilder) trafficSteeringPolicy() string {
+	if b.dstInterface == ie.DstInterfaceCore {
+		return b.uplinkSteeringPolicy
+	}
+
+	return b.downlinkSteeringPolicy
+}
@@ -140,6 +140,60 @@ func TestFARBuilder(t *testing.T) {
 			),
 			description: "Valid FAR actions with 3 flags",
 		},
+		{
+			input: NewFARBuilder().
+				WithID(1).
+				WithMethod(Create).
+				WithAction(ActionForward).
+				WithDstInterface(ie.DstInterfaceCore).
+				WithTrafficSteering("sfc-ul", "sfc-dl"),
+			expected: ie.NewCreateFAR(
+				ie.NewFARID(1),
+				ie.NewApplyAction(ActionForward),
+				ie.NewForwardingParameters(
+					ie.NewDestinationInterface(ie.DstInterfaceCore),
+					ie.NewForwardingPolicy("sfc-ul"),
+				),
+			),
+			description: "Valid uplink FAR with traffic steering",
+		},
+		{
+			input: NewFARBuilder().
+				WithID(2).
+				WithMethod(Create).
+				WithAction(ActionForward).
+				WithDstInterface(ie.DstInterfaceAccess).
+				WithTEID(12).
+				WithDownlinkIP("10.0.0.1").
+				WithTrafficSteering("sfc-ul", "sfc-dl"),
+			expected: ie.NewCreateFAR(
+				ie.NewFARID(2),
+				ie.NewApplyAction(ActionForward),
+				ie.NewForwardingParameters(
+					ie.NewDestinationInterface(ie.DstInterfaceAccess),
+					ie.NewOuterHeaderCreation(S_TAG, 12, "10.0.0.1", "", 0, 0, 0),
+					ie.NewForwardingPolicy("sfc-dl"),
+				),
+			),
+			description: "Valid downlink FAR with traffic steering",
+		},
+		{
+			input: NewFARBuilder().
+				WithID(1).
+				WithMethod(Create).
+				WithAction(ActionForward).
+				WithDstInterface(ie.DstInterfaceCore).
+				WithNetworkInstance("ims"),
+			expected: ie.NewCreateFAR(
+				ie.NewFARID(1),
+				ie.NewApplyAction(ActionForward),
+				ie.NewForwardingParameters(
+					ie.NewDestinationInterface(ie.DstInterfaceCore),
+					ie.NewNetworkInstanceFQDN("ims"),
+				),
+			),
+			description: "Valid uplink FAR with network instance",
+		},
 	} {
 		t.Run(scenario.description, func(t *testing.T) {
 			require.Equal(t, scenario.expected, scenario.input.BuildFAR())
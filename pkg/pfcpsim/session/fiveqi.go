@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package session
+
+type ResourceType uint8
+
+const (
+	NonGBR ResourceType = iota
+	GBR
+	DelayCriticalGBR
+)
+
+// FiveQICharacteristics describes the QoS characteristics of a standardized 5QI.
+// Bit rates are expressed in kbps, as encoded in MBR and GBR IEs.
+type FiveQICharacteristics struct {
+	ResourceType ResourceType
+	// PriorityLevel is the default priority level. The lowest value is the highest priority
+	PriorityLevel uint8
+	// PacketDelayBudget in milliseconds
+	PacketDelayBudget uint16
+
+	UplinkMBR   uint64
+	DownlinkMBR uint64
+	UplinkGBR   uint64
+	DownlinkGBR uint64
+}
+
+// fiveQITable maps standardized 5QIs to their characteristics. Refer to table 5.7.4-1 in 3GPP TS 23.501 Release 16.
+// The specs do not define bit rates: the ones below are sensible defaults for the example services of each 5QI.
+var fiveQITable = map[uint8]FiveQICharacteristics{
+	// GBR
+	1:  {ResourceType: GBR, PriorityLevel: 20, PacketDelayBudget: 100, UplinkMBR: 128, DownlinkMBR: 128, UplinkGBR: 128, DownlinkGBR: 128},
+	2:  {ResourceType: GBR, PriorityLevel: 40, PacketDelayBudget: 150, UplinkMBR: 4000, DownlinkMBR: 4000, UplinkGBR: 2000, DownlinkGBR: 2000},
+	3:  {ResourceType: GBR, PriorityLevel: 30, PacketDelayBudget: 50, UplinkMBR: 2000, DownlinkMBR: 2000, UplinkGBR: 1000, DownlinkGBR: 1000},
+	4:  {ResourceType: GBR, PriorityLevel: 50, PacketDelayBudget: 300, UplinkMBR: 8000, DownlinkMBR: 8000, UplinkGBR: 2000, DownlinkGBR: 2000},
+	65: {ResourceType: GBR, PriorityLevel: 7, PacketDelayBudget: 75, UplinkMBR: 128, DownlinkMBR: 128, UplinkGBR: 128, DownlinkGBR: 128},
+	66: {ResourceType: GBR, PriorityLevel: 20, PacketDelayBudget: 100, UplinkMBR: 128, DownlinkMBR: 128, UplinkGBR: 128, DownlinkGBR: 128},
+	67: {ResourceType: GBR, PriorityLevel: 15, PacketDelayBudget: 100, UplinkMBR: 4000, DownlinkMBR: 4000, UplinkGBR: 2000, DownlinkGBR: 2000},
+	// Non-GBR
+	5:  {ResourceType: NonGBR, PriorityLevel: 10, PacketDelayBudget: 100, UplinkMBR: 1000, DownlinkMBR: 1000},
+	6:  {ResourceType: NonGBR, PriorityLevel: 60, PacketDelayBudget: 300, UplinkMBR: 60000, DownlinkMBR: 60000},
+	7:  {ResourceType: NonGBR, PriorityLevel: 70, PacketDelayBudget: 100, UplinkMBR: 10000, DownlinkMBR: 10000},
+	8:  {ResourceType: NonGBR, PriorityLevel: 80, PacketDelayBudget: 300, UplinkMBR: 60000, DownlinkMBR: 60000},
+	9:  {ResourceType: NonGBR, PriorityLevel: 90, PacketDelayBudget: 300, UplinkMBR: 60000, DownlinkMBR: 60000},
+	69: {ResourceType: NonGBR, PriorityLevel: 5, PacketDelayBudget: 60, UplinkMBR: 1000, DownlinkMBR: 1000},
+	70: {ResourceType: NonGBR, PriorityLevel: 55, PacketDelayBudget: 200, UplinkMBR: 60000, DownlinkMBR: 60000},
+	79: {ResourceType: NonGBR, PriorityLevel: 65, PacketDelayBudget: 50, UplinkMBR: 10000, DownlinkMBR: 10000},
+	80: {ResourceType: NonGBR, PriorityLevel: 68, PacketDelayBudget: 10, UplinkMBR: 60000, DownlinkMBR: 60000},
+	// Delay-critical GBR
+	82: {ResourceType: DelayCriticalGBR, PriorityLevel: 19, PacketDelayBudget: 10, UplinkMBR: 2000, DownlinkMBR: 2000, UplinkGBR: 1000, DownlinkGBR: 1000},
+	83: {ResourceType: DelayCriticalGBR, PriorityLevel: 22, PacketDelayBudget: 10, UplinkMBR: 2000, DownlinkMBR: 2000, UplinkGBR: 1000, DownlinkGBR: 1000},
+	84: {ResourceType: DelayCriticalGBR, PriorityLevel: 24, PacketDelayBudget: 30, UplinkMBR: 2000, DownlinkMBR: 2000, UplinkGBR: 1000, DownlinkGBR: 1000},
+	85: {ResourceType: DelayCriticalGBR, PriorityLevel: 21, PacketDelayBudget: 5, UplinkMBR: 2000, DownlinkMBR: 2000, UplinkGBR: 1000, DownlinkGBR: 1000},
+}
+
+// GetFiveQICharacteristics returns the characteristics of a standardized 5QI.
+// Returns false if fiveQI is not a standardized 5QI.
+func GetFiveQICharacteristics(fiveQI uint8) (FiveQICharacteristics, bool) {
+	characteristics, ok := fiveQITable[fiveQI]
+	return characteristics, ok
+}
@@ -24,4 +24,28 @@ const (
 	ActionNotify  uint8 = 0x8
 
 	S_TAG = 0x100 // Refer to table 8.2.56-1 in PFCP specs Release 16
+
+	// Reporting triggers. Refer to section 8.2.19 in PFCP specs Release 16
+	ReportingTriggerPeriodic        uint16 = 0x0100
+	ReportingTriggerVolumeThreshold uint16 = 0x0200
+	ReportingTriggerTimeThreshold   uint16 = 0x0400
+	ReportingTriggerEventThreshold  uint16 = 0x0010
+
+	// Volume Threshold flags. Refer to section 8.2.13 in PFCP specs Release 16
+	volumeThresholdTOVOL uint8 = 0x01
+
+	// UE IP Address flags. Refer to section 8.2.62 in PFCP specs Release 16
+	ueIPAddressV6    uint8 = 0x01
+	ueIPAddressV4    uint8 = 0x02
+	ueIPAddressIPv6D uint8 = 0x08
+	ueIPAddressIP6PL uint8 = 0x40
+
+	// maxForwardingPolicyIDLength is the maximum length of a Forwarding Policy Identifier. Refer to section 8.2.23 in PFCP specs Release 16
+	maxForwardingPolicyIDLength = 255
+
+	// defaultNetworkInstance is the Network Instance of the UE side PDIs and Traffic Endpoints, if none is set
+	defaultNetworkInstance = "internet"
+
+	// defaultIPv6PrefixLength is the prefix length assumed by the UP function when no IPv6 prefix delegation bits are provided
+	defaultIPv6PrefixLength uint8 = 64
 )
@@ -18,10 +18,24 @@ type pdrBuilder struct {
 	farID      uint32
 
 	qerIDs []*ie.IE
+	urrIDs []*ie.IE
 
-	ueAddress string
-	n3Address string
-	direction direction
+	predefinedRules []*ie.IE
+
+	// multicastAddress and multicastSourceAddress match the IP multicast traffic of a MBS session, if set
+	multicastAddress       string
+	multicastSourceAddress string
+
+	// networkInstance is the Network Instance (i.e. the DNN) of the downlink PDI. Defaults to defaultNetworkInstance
+	networkInstance string
+
+	// trafficEndpointID, if set, references the Traffic Endpoint carrying the F-TEID and UE IP address in the PDI
+	trafficEndpointID uint8
+
+	ueAddress      string
+	uePrefixLength uint8
+	n3Address      string
+	direction      direction
 }
 
 func NewPDRBuilder() *pdrBuilder {
@@ -60,16 +74,58 @@ func (b *pdrBuilder) WithN3Address(n3Address string) *pdrBuilder {
 	return b
 }
 
+// WithNetworkInstance sets the Network Instance of the downlink PDI, i.e. the DNN the traffic comes from.
+// If not set, "internet" is used.
+func (b *pdrBuilder) WithNetworkInstance(networkInstance string) *pdrBuilder {
+	b.networkInstance = networkInstance
+	return b
+}
+
 func (b *pdrBuilder) WithUEAddress(ueAddress string) *pdrBuilder {
 	b.ueAddress = ueAddress
 	return b
 }
 
+// WithUEPrefixLength marks the UE address as an IPv6 prefix of the given length, delegated to the UE.
+// Zero means the UE address is a single address.
+func (b *pdrBuilder) WithUEPrefixLength(prefixLength uint8) *pdrBuilder {
+	b.uePrefixLength = prefixLength
+	return b
+}
+
 func (b *pdrBuilder) AddQERID(qerID uint32) *pdrBuilder {
 	b.qerIDs = append(b.qerIDs, ie.NewQERID(qerID))
 	return b
 }
 
+func (b *pdrBuilder) AddURRID(urrID uint32) *pdrBuilder {
+	b.urrIDs = append(b.urrIDs, ie.NewURRID(urrID))
+	return b
+}
+
+// WithPredefinedRule activates the rule predefined in the UP function with the given name.
+// It can be invoked multiple times to activate several predefined rules.
+func (b *pdrBuilder) WithPredefinedRule(name string) *pdrBuilder {
+	b.predefinedRules = append(b.predefinedRules, ie.NewActivatePredefinedRules(name))
+	return b
+}
+
+// WithIPMulticastAddressingInfo makes the PDR match the IP multicast traffic sent to multicastAddress,
+// e.g. for 5G Multicast-Broadcast Services. sourceAddress, if not empty, restricts it to a single source.
+func (b *pdrBuilder) WithIPMulticastAddressingInfo(multicastAddress string, sourceAddress string) *pdrBuilder {
+	b.multicastAddress = multicastAddress
+	b.multicastSourceAddress = sourceAddress
+
+	return b
+}
+
+// WithTrafficEndpointID makes the PDI reference the Traffic Endpoint with the given ID (see NewTrafficEndpointBuilder),
+// instead of carrying the F-TEID or UE IP address itself.
+func (b *pdrBuilder) WithTrafficEndpointID(id uint8) *pdrBuilder {
+	b.trafficEndpointID = id
+	return b
+}
+
 func (b *pdrBuilder) WithFARID(farID uint32) *pdrBuilder {
 	b.farID = farID
 	return b
@@ -98,10 +154,35 @@ func (b *pdrBuilder) validate() {
 		panic("Tried building PDR without providing FAR ID")
 	}
 
+	if b.multicastAddress != "" {
+		if ip := net.ParseIP(b.multicastAddress); ip == nil || !ip.IsMulticast() {
+			panic("Tried building PDR with an invalid IP multicast address")
+		}
+
+		if b.multicastSourceAddress != "" && net.ParseIP(b.multicastSourceAddress) == nil {
+			panic("Tried building PDR with an invalid IP multicast source address")
+		}
+	}
+
+	if b.trafficEndpointID != 0 {
+		// the Traffic Endpoint carries the F-TEID and UE IP address
+		return
+	}
+
 	if b.direction == downlink {
 		if b.ueAddress == "" {
 			panic("Tried building downlink PDR without setting the UE IP address")
 		}
+
+		if b.uePrefixLength != 0 {
+			if ip := net.ParseIP(b.ueAddress); ip == nil || ip.To4() != nil {
+				panic("Tried building downlink PDR with an IPv6 prefix length but no IPv6 UE address")
+			}
+
+			if b.uePrefixLength > net.IPv6len*8 {
+				panic("Tried building downlink PDR with an invalid IPv6 prefix length")
+			}
+		}
 	}
 
 	if b.direction == uplink {
@@ -115,6 +196,56 @@ func (b *pdrBuilder) validate() {
 	}
 }
 
+// withDefaultNetworkInstance returns networkInstance, or defaultNetworkInstance if it is empty.
+func withDefaultNetworkInstance(networkInstance string) string {
+	if networkInstance == "" {
+		return defaultNetworkInstance
+	}
+
+	return networkInstance
+}
+
+// newUEIPAddress returns the UE IP Address IE carrying either the single UE address or,
+// if prefixLength is not zero, the delegated IPv6 prefix.
+func newUEIPAddress(ueAddress string, prefixLength uint8) *ie.IE {
+	if prefixLength != 0 {
+		if prefixLength < defaultIPv6PrefixLength {
+			// delegation bits are the number of bits of the prefix beyond the default /64
+			return ie.NewUEIPAddress(ueIPAddressV6|ueIPAddressIPv6D, "", ueAddress, defaultIPv6PrefixLength-prefixLength, 0)
+		}
+
+		return ie.NewUEIPAddress(ueIPAddressV6|ueIPAddressIP6PL, "", ueAddress, 0, prefixLength)
+	}
+
+	if ip := net.ParseIP(ueAddress); ip != nil && ip.To4() == nil {
+		return ie.NewUEIPAddress(ueIPAddressV6, "", ueAddress, 0, 0)
+	}
+
+	return ie.NewUEIPAddress(ueIPAddressV4, ueAddress, "", 0, 0)
+}
+
+// newIPMulticastAddressingInfo returns the IP Multicast Addressing Info IE carrying the multicast address
+// and, if set, the multicast source address.
+func (b *pdrBuilder) newIPMulticastAddressingInfo() *ie.IE {
+	multicastAddress := net.ParseIP(b.multicastAddress)
+
+	var ies []*ie.IE
+
+	if multicastAddress.To4() != nil {
+		ies = append(ies, ie.NewIPMulticastAddress(multicastAddress, nil, nil, nil))
+	} else {
+		ies = append(ies, ie.NewIPMulticastAddress(nil, multicastAddress, nil, nil))
+	}
+
+	if source := net.ParseIP(b.multicastSourceAddress); source.To4() != nil {
+		ies = append(ies, ie.NewSourceIPAddress(source, nil, 0))
+	} else if source != nil {
+		ies = append(ies, ie.NewSourceIPAddress(nil, source, 0))
+	}
+
+	return ie.NewIPMulticastAddressingInfo(ies...)
+}
+
 func newRemovePDR(pdr *ie.IE) *ie.IE {
 	return ie.NewRemovePDR(pdr)
 }
@@ -130,11 +261,13 @@ func (b *pdrBuilder) BuildPDR() *ie.IE {
 	}
 
 	if b.direction == downlink {
-		pdi := ie.NewPDI(
-			ie.NewSourceInterface(ie.SrcInterfaceCore),
-			ie.NewNetworkInstanceFQDN("internet"),
-			ie.NewUEIPAddress(0x2, b.ueAddress, "", 0, 0),
-		)
+		pdi := ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceCore))
+
+		if b.trafficEndpointID != 0 {
+			pdi.Add(ie.NewTrafficEndpointID(b.trafficEndpointID))
+		} else {
+			pdi.Add(ie.NewNetworkInstanceFQDN(withDefaultNetworkInstance(b.networkInstance)), newUEIPAddress(b.ueAddress, b.uePrefixLength))
+		}
 
 		if b.sdfFilter != "" {
 			pdi.Add(ie.NewSDFFilter(b.sdfFilter, "", "", "", 1))
@@ -148,6 +281,12 @@ func (b *pdrBuilder) BuildPDR() *ie.IE {
 
 		pdr.Add(pdi)
 		pdr.Add(b.qerIDs...)
+		pdr.Add(b.urrIDs...)
+		pdr.Add(b.predefinedRules...)
+
+		if b.multicastAddress != "" {
+			pdr.Add(b.newIPMulticastAddressingInfo())
+		}
 
 		if b.method == Delete {
 			return newRemovePDR(pdr)
@@ -157,10 +296,13 @@ func (b *pdrBuilder) BuildPDR() *ie.IE {
 	}
 
 	// UplinkPDR
-	pdi := ie.NewPDI(
-		ie.NewSourceInterface(ie.SrcInterfaceAccess),
-		ie.NewFTEID(0x01, b.teid, net.ParseIP(b.n3Address), nil, 0),
-	)
+	pdi := ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess))
+
+	if b.trafficEndpointID != 0 {
+		pdi.Add(ie.NewTrafficEndpointID(b.trafficEndpointID))
+	} else {
+		pdi.Add(ie.NewFTEID(0x01, b.teid, net.ParseIP(b.n3Address), nil, 0))
+	}
 
 	if b.sdfFilter != "" {
 		pdi.Add(ie.NewSDFFilter(b.sdfFilter, "", "", "", 1))
@@ -175,6 +317,12 @@ func (b *pdrBuilder) BuildPDR() *ie.IE {
 
 	pdr.Add(pdi)
 	pdr.Add(b.qerIDs...)
+	pdr.Add(b.urrIDs...)
+	pdr.Add(b.predefinedRules...)
+
+	if b.multicastAddress != "" {
+		pdr.Add(b.newIPMulticastAddressingInfo())
+	}
 
 	if b.method == Delete {
 		newRemovePDR(pdr)
@@ -84,6 +84,46 @@ func TestPDRBuilderShouldPanic(t *testing.T) {
 			},
 			description: "Invalid Downlink PDR: marked as uplink passing downlink parameters",
 		},
+		{
+			input: NewPDRBuilder().
+				WithID(1).
+				WithMethod(Create).
+				WithUEAddress("10.0.0.1").
+				WithUEPrefixLength(64).
+				WithFARID(3).
+				AddQERID(4).
+				MarkAsDownlink(),
+			expected: &pdrBuilder{
+				id:             1,
+				method:         Create,
+				ueAddress:      "10.0.0.1",
+				uePrefixLength: 64,
+				farID:          3,
+				direction:      downlink,
+				qerIDs:         []*ie.IE{ie.NewQERID(4)},
+			},
+			description: "Invalid Downlink PDR: IPv6 prefix length with an IPv4 UE address",
+		},
+		{
+			input: NewPDRBuilder().
+				WithID(1).
+				WithMethod(Create).
+				WithUEAddress("10.0.0.1").
+				WithIPMulticastAddressingInfo("10.0.0.2", "").
+				WithFARID(3).
+				AddQERID(4).
+				MarkAsDownlink(),
+			expected: &pdrBuilder{
+				id:               1,
+				method:           Create,
+				ueAddress:        "10.0.0.1",
+				multicastAddress: "10.0.0.2",
+				farID:            3,
+				direction:        downlink,
+				qerIDs:           []*ie.IE{ie.NewQERID(4)},
+			},
+			description: "Invalid Downlink PDR: unicast IP multicast address",
+		},
 	} {
 		t.Run(scenario.description, func(t *testing.T) {
 			assert.Panics(t, func() { scenario.input.BuildPDR() })
@@ -125,6 +165,84 @@ func TestPDRBuilder(t *testing.T) {
 			),
 			description: "Valid Create Uplink PDR",
 		},
+		{
+			input: NewPDRBuilder().
+				WithID(1).
+				WithPrecedence(2).
+				WithTEID(100).
+				WithMethod(Create).
+				WithN3Address("192.168.0.1").
+				WithFARID(3).
+				AddQERID(4).
+				AddURRID(5).
+				MarkAsUplink(),
+			expected: ie.NewCreatePDR(
+				ie.NewPDRID(1),
+				ie.NewPrecedence(2),
+				ie.NewOuterHeaderRemoval(0, 0),
+				ie.NewFARID(3),
+				ie.NewPDI(
+					ie.NewSourceInterface(ie.SrcInterfaceAccess),
+					ie.NewFTEID(0x01, 100, net.ParseIP("192.168.0.1"), nil, 0),
+				),
+				ie.NewQERID(4),
+				ie.NewURRID(5),
+			),
+			description: "Valid Create Uplink PDR with URR",
+		},
+		{
+			input: NewPDRBuilder().
+				WithID(1).
+				WithPrecedence(2).
+				WithUEAddress("172.16.0.1").
+				WithMethod(Create).
+				WithFARID(3).
+				AddQERID(4).
+				WithPredefinedRule("video-optimization").
+				WithPredefinedRule("zero-rating").
+				MarkAsDownlink(),
+			expected: ie.NewCreatePDR(
+				ie.NewPDRID(1),
+				ie.NewPrecedence(2),
+				ie.NewFARID(3),
+				ie.NewPDI(
+					ie.NewSourceInterface(ie.SrcInterfaceCore),
+					ie.NewNetworkInstanceFQDN("internet"),
+					ie.NewUEIPAddress(0x2, "172.16.0.1", "", 0, 0),
+				),
+				ie.NewQERID(4),
+				ie.NewActivatePredefinedRules("video-optimization"),
+				ie.NewActivatePredefinedRules("zero-rating"),
+			),
+			description: "Valid Create Downlink PDR with predefined rules",
+		},
+		{
+			input: NewPDRBuilder().
+				WithID(1).
+				WithPrecedence(2).
+				WithUEAddress("172.16.0.1").
+				WithMethod(Create).
+				WithFARID(3).
+				AddQERID(4).
+				WithIPMulticastAddressingInfo("232.0.0.1", "10.0.0.1").
+				MarkAsDownlink(),
+			expected: ie.NewCreatePDR(
+				ie.NewPDRID(1),
+				ie.NewPrecedence(2),
+				ie.NewFARID(3),
+				ie.NewPDI(
+					ie.NewSourceInterface(ie.SrcInterfaceCore),
+					ie.NewNetworkInstanceFQDN("internet"),
+					ie.NewUEIPAddress(0x2, "172.16.0.1", "", 0, 0),
+				),
+				ie.NewQERID(4),
+				ie.NewIPMulticastAddressingInfo(
+					ie.NewIPMulticastAddress(net.ParseIP("232.0.0.1"), nil, nil, nil),
+					ie.NewSourceIPAddress(net.ParseIP("10.0.0.1"), nil, 0),
+				),
+			),
+			description: "Valid Create Downlink PDR with IP multicast addressing info",
+		},
 		{
 			input: NewPDRBuilder().
 				WithID(1).
@@ -142,6 +260,7 @@ func TestPDRBuilder(t *testing.T) {
 				ie.NewFARID(3),
 				ie.NewPDI(
 					ie.NewSourceInterface(ie.SrcInterfaceCore),
+					ie.NewNetworkInstanceFQDN("internet"),
 					ie.NewUEIPAddress(0x2, "172.16.0.1", "", 0, 0),
 					ie.NewSDFFilter("permit ip any to assigned", "", "", "", 1),
 				),
@@ -165,6 +284,7 @@ func TestPDRBuilder(t *testing.T) {
 				ie.NewFARID(3),
 				ie.NewPDI(
 					ie.NewSourceInterface(ie.SrcInterfaceCore),
+					ie.NewNetworkInstanceFQDN("internet"),
 					ie.NewUEIPAddress(0x2, "172.16.0.1", "", 0, 0),
 				),
 				ie.NewQERID(4),
@@ -188,6 +308,7 @@ func TestPDRBuilder(t *testing.T) {
 					ie.NewFARID(3),
 					ie.NewPDI(
 						ie.NewSourceInterface(ie.SrcInterfaceCore),
+						ie.NewNetworkInstanceFQDN("internet"),
 						ie.NewUEIPAddress(0x2, "172.16.0.1", "", 0, 0),
 					),
 					ie.NewQERID(4),
@@ -195,6 +316,52 @@ func TestPDRBuilder(t *testing.T) {
 			),
 			description: "Valid Delete Downlink PDR",
 		},
+		{
+			input: NewPDRBuilder().
+				WithID(1).
+				WithPrecedence(2).
+				WithUEAddress("2001:db8:1::").
+				WithUEPrefixLength(64).
+				WithMethod(Create).
+				WithFARID(3).
+				AddQERID(4).
+				MarkAsDownlink(),
+			expected: ie.NewCreatePDR(
+				ie.NewPDRID(1),
+				ie.NewPrecedence(2),
+				ie.NewFARID(3),
+				ie.NewPDI(
+					ie.NewSourceInterface(ie.SrcInterfaceCore),
+					ie.NewNetworkInstanceFQDN("internet"),
+					ie.NewUEIPAddress(0x41, "", "2001:db8:1::", 0, 64),
+				),
+				ie.NewQERID(4),
+			),
+			description: "Valid Create Downlink PDR with IPv6 prefix",
+		},
+		{
+			input: NewPDRBuilder().
+				WithID(1).
+				WithPrecedence(2).
+				WithUEAddress("2001:db8:1::").
+				WithUEPrefixLength(56).
+				WithMethod(Create).
+				WithFARID(3).
+				AddQERID(4).
+				MarkAsDownlink(),
+			expected: ie.NewCreatePDR(
+				ie.NewPDRID(1),
+				ie.NewPrecedence(2),
+				ie.NewFARID(3),
+				ie.NewPDI(
+					ie.NewSourceInterface(ie.SrcInterfaceCore),
+					ie.NewNetworkInstanceFQDN("internet"),
+					ie.NewUEIPAddress(0x09, "", "2001:db8:1::", 8, 0),
+				),
+				ie.NewQERID(4),
+			),
+			description: "Valid Create Downlink PDR with delegated IPv6 prefix",
+		},
 	} {
 		t.Run(scenario.description, func(t *testing.T) {
 			assert.NotPanics(t, func() { scenario.input.BuildPDR() })
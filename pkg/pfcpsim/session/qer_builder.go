@@ -16,8 +16,15 @@ type qerBuilder struct {
 	ulGbr      uint64
 	dlGbr      uint64
 	gateStatus uint8
+	fiveQI     uint8
 
 	isIDSet bool
+
+	// used to not override explicitly set bit rates with the 5QI defaults
+	isULMbrSet bool
+	isDLMbrSet bool
+	isULGbrSet bool
+	isDLGbrSet bool
 }
 
 func NewQERBuilder() *qerBuilder {
@@ -39,6 +46,7 @@ func (b *qerBuilder) WithQFI(qfi uint8) *qerBuilder {
 
 func (b *qerBuilder) WithUplinkMBR(ulMbr uint64) *qerBuilder {
 	b.isMbrSet = true
+	b.isULMbrSet = true
 	b.ulMbr = ulMbr
 
 	return b
@@ -46,6 +54,7 @@ func (b *qerBuilder) WithUplinkMBR(ulMbr uint64) *qerBuilder {
 
 func (b *qerBuilder) WithUplinkGBR(ulGbr uint64) *qerBuilder {
 	b.isGbrSet = true
+	b.isULGbrSet = true
 	b.ulGbr = ulGbr
 
 	return b
@@ -53,6 +62,7 @@ func (b *qerBuilder) WithUplinkGBR(ulGbr uint64) *qerBuilder {
 
 func (b *qerBuilder) WithDownlinkMBR(dlMbr uint64) *qerBuilder {
 	b.isMbrSet = true
+	b.isDLMbrSet = true
 	b.dlMbr = dlMbr
 
 	return b
@@ -60,6 +70,7 @@ func (b *qerBuilder) WithDownlinkMBR(dlMbr uint64) *qerBuilder {
 
 func (b *qerBuilder) WithDownlinkGBR(dlGbr uint64) *qerBuilder {
 	b.isGbrSet = true
+	b.isDLGbrSet = true
 	b.dlGbr = dlGbr
 
 	return b
@@ -71,10 +82,21 @@ func (b *qerBuilder) WithGateStatus(status uint8) *qerBuilder {
 	return b
 }
 
+// WithFiveQI sets QFI, MBR and GBR (for GBR 5QIs) according to the characteristics of a standardized 5QI.
+// Values explicitly set through the other methods are not overridden. QFI defaults to the 5QI value.
+func (b *qerBuilder) WithFiveQI(fiveQI uint8) *qerBuilder {
+	b.fiveQI = fiveQI
+	return b
+}
+
 func (b *qerBuilder) validate() {
 	if !b.isIDSet {
 		panic("Tried to build a QER without setting the QER ID")
 	}
+
+	if _, ok := GetFiveQICharacteristics(b.fiveQI); b.fiveQI != 0 && !ok {
+		panic("Tried to build a QER with a non-standardized 5QI")
+	}
 }
 
 func (b *qerBuilder) WithMethod(method IEMethod) *qerBuilder {
@@ -95,18 +117,50 @@ func (b *qerBuilder) Build() *ie.IE {
 		gate = ie.NewGateStatus(ie.GateStatusClosed, ie.GateStatusClosed)
 	}
 
+	qfi := b.qfi
+	isMbrSet, ulMbr, dlMbr := b.isMbrSet, b.ulMbr, b.dlMbr
+	isGbrSet, ulGbr, dlGbr := b.isGbrSet, b.ulGbr, b.dlGbr
+
+	if characteristics, ok := GetFiveQICharacteristics(b.fiveQI); ok {
+		if qfi == 0 {
+			qfi = b.fiveQI
+		}
+
+		isMbrSet = true
+
+		if !b.isULMbrSet {
+			ulMbr = characteristics.UplinkMBR
+		}
+
+		if !b.isDLMbrSet {
+			dlMbr = characteristics.DownlinkMBR
+		}
+
+		if characteristics.ResourceType != NonGBR {
+			isGbrSet = true
+
+			if !b.isULGbrSet {
+				ulGbr = characteristics.UplinkGBR
+			}
+
+			if !b.isDLGbrSet {
+				dlGbr = characteristics.DownlinkGBR
+			}
+		}
+	}
+
 	qer := createFunc(
 		ie.NewQERID(b.qerID),
-		ie.NewQFI(b.qfi),
+		ie.NewQFI(qfi),
 		gate,
 	)
 
-	if b.isMbrSet {
-		qer.Add(ie.NewMBR(b.ulMbr, b.dlMbr))
+	if isMbrSet {
+		qer.Add(ie.NewMBR(ulMbr, dlMbr))
 	}
 
-	if b.isGbrSet {
-		qer.Add(ie.NewGBR(b.ulGbr, b.dlGbr))
+	if isGbrSet {
+		qer.Add(ie.NewGBR(ulGbr, dlGbr))
 	}
 
 	if b.method == Delete {
@@ -28,6 +28,19 @@ func TestQERBuilderShouldPanic(t *testing.T) {
 			},
 			description: "Invalid QER: No ID provided",
 		},
+		{
+			input: NewQERBuilder().
+				WithID(1).
+				WithMethod(Create).
+				WithFiveQI(10),
+			expected: &qerBuilder{
+				method:  Create,
+				qerID:   1,
+				isIDSet: true,
+				fiveQI:  10,
+			},
+			description: "Invalid QER: Non-standardized 5QI",
+		},
 	} {
 		t.Run(scenario.description, func(t *testing.T) {
 			assert.Panics(t, func() { scenario.input.Build() })
@@ -101,6 +114,49 @@ func TestQERBuilder(t *testing.T) {
 			),
 			description: "Valid Delete QER",
 		},
+		{
+			input: NewQERBuilder().
+				WithID(1).
+				WithMethod(Create).
+				WithFiveQI(1),
+			expected: ie.NewCreateQER(
+				ie.NewQERID(1),
+				ie.NewQFI(1),
+				ie.NewGateStatus(0, 0),
+				ie.NewMBR(128, 128),
+				ie.NewGBR(128, 128),
+			),
+			description: "Valid Create QER with GBR 5QI",
+		},
+		{
+			input: NewQERBuilder().
+				WithID(1).
+				WithMethod(Create).
+				WithFiveQI(9),
+			expected: ie.NewCreateQER(
+				ie.NewQERID(1),
+				ie.NewQFI(9),
+				ie.NewGateStatus(0, 0),
+				ie.NewMBR(60000, 60000),
+			),
+			description: "Valid Create QER with Non-GBR 5QI",
+		},
+		{
+			input: NewQERBuilder().
+				WithID(1).
+				WithMethod(Create).
+				WithQFI(5).
+				WithDownlinkMBR(500).
+				WithFiveQI(2),
+			expected: ie.NewCreateQER(
+				ie.NewQERID(1),
+				ie.NewQFI(5),
+				ie.NewGateStatus(0, 0),
+				ie.NewMBR(4000, 500),
+				ie.NewGBR(2000, 2000),
+			),
+			description: "Valid Create QER with 5QI and overridden values",
+		},
 	} {
 		t.Run(scenario.description, func(t *testing.T) {
 			assert.NotPanics(t, func() { _ = scenario.input.Build() })
@@ -108,3 +164,14 @@ func TestQERBuilder(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFiveQICharacteristics(t *testing.T) {
+	characteristics, ok := GetFiveQICharacteristics(1)
+	assert.True(t, ok)
+	assert.Equal(t, GBR, characteristics.ResourceType)
+	assert.Equal(t, uint8(20), characteristics.PriorityLevel)
+	assert.Equal(t, uint16(100), characteristics.PacketDelayBudget)
+
+	_, ok = GetFiveQICharacteristics(10)
+	assert.False(t, ok)
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package session
+
+import (
+	"net"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// trafficEndpointBuilder builds a Traffic Endpoint carrying both the uplink F-TEID and the UE IP address,
+// so that it can be shared by the uplink and downlink PDRs of a session (see WithTrafficEndpointID).
+type trafficEndpointBuilder struct {
+	id             uint8
+	teid           uint32
+	n3Address      string
+	ueAddress      string
+	uePrefixLength uint8
+	// networkInstance is the Network Instance (i.e. the DNN). Defaults to defaultNetworkInstance
+	networkInstance string
+}
+
+// NewTrafficEndpointBuilder returns a trafficEndpointBuilder.
+func NewTrafficEndpointBuilder() *trafficEndpointBuilder {
+	return &trafficEndpointBuilder{}
+}
+
+func (b *trafficEndpointBuilder) WithID(id uint8) *trafficEndpointBuilder {
+	b.id = id
+	return b
+}
+
+func (b *trafficEndpointBuilder) WithTEID(teid uint32) *trafficEndpointBuilder {
+	b.teid = teid
+	return b
+}
+
+func (b *trafficEndpointBuilder) WithN3Address(n3Address string) *trafficEndpointBuilder {
+	b.n3Address = n3Address
+	return b
+}
+
+func (b *trafficEndpointBuilder) WithUEAddress(ueAddress string) *trafficEndpointBuilder {
+	b.ueAddress = ueAddress
+	return b
+}
+
+// WithUEPrefixLength marks the UE address as an IPv6 prefix of the given length, delegated to the UE.
+// Zero means the UE address is a single address.
+func (b *trafficEndpointBuilder) WithUEPrefixLength(prefixLength uint8) *trafficEndpointBuilder {
+	b.uePrefixLength = prefixLength
+	return b
+}
+
+// WithNetworkInstance sets the Network Instance, i.e. the DNN of the UE. If not set, "internet" is used.
+func (b *trafficEndpointBuilder) WithNetworkInstance(networkInstance string) *trafficEndpointBuilder {
+	b.networkInstance = networkInstance
+	return b
+}
+
+func (b *trafficEndpointBuilder) validate() {
+	if b.id == 0 {
+		panic("Tried building Traffic Endpoint without providing ID")
+	}
+
+	if b.teid == 0 {
+		panic("Tried building Traffic Endpoint without setting the TEID")
+	}
+
+	if net.ParseIP(b.n3Address) == nil {
+		panic("Tried building Traffic Endpoint without setting a valid N3Address")
+	}
+
+	if net.ParseIP(b.ueAddress) == nil {
+		panic("Tried building Traffic Endpoint without setting a valid UE IP address")
+	}
+}
+
+// Build returns a Create Traffic Endpoint IE.
+func (b *trafficEndpointBuilder) Build() *ie.IE {
+	b.validate()
+
+	return ie.NewCreateTrafficEndpoint(
+		ie.NewTrafficEndpointID(b.id),
+		ie.NewFTEID(0x01, b.teid, net.ParseIP(b.n3Address), nil, 0),
+		ie.NewNetworkInstanceFQDN(withDefaultNetworkInstance(b.networkInstance)),
+		newUEIPAddress(b.ueAddress, b.uePrefixLength),
+	)
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package session
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func TestTrafficEndpointBuilderShouldPanic(t *testing.T) {
+	for _, scenario := range []struct {
+		input       *trafficEndpointBuilder
+		description string
+	}{
+		{
+			input: NewTrafficEndpointBuilder().
+				WithTEID(1).
+				WithN3Address("10.0.0.1").
+				WithUEAddress("17.0.0.1"),
+			description: "Invalid Traffic Endpoint: No ID provided",
+		},
+		{
+			input: NewTrafficEndpointBuilder().
+				WithID(1).
+				WithN3Address("10.0.0.1").
+				WithUEAddress("17.0.0.1"),
+			description: "Invalid Traffic Endpoint: No TEID provided",
+		},
+		{
+			input: NewTrafficEndpointBuilder().
+				WithID(1).
+				WithTEID(1).
+				WithN3Address("10.0.0.1"),
+			description: "Invalid Traffic Endpoint: No UE address provided",
+		},
+	} {
+		t.Run(scenario.description, func(t *testing.T) {
+			assert.Panics(t, func() { scenario.input.Build() })
+		})
+	}
+}
+
+func TestTrafficEndpointBuilder(t *testing.T) {
+	trafficEndpoint := NewTrafficEndpointBuilder().
+		WithID(1).
+		WithTEID(100).
+		WithN3Address("10.0.0.1").
+		WithUEAddress("17.0.0.1").
+		Build()
+
+	expected := ie.NewCreateTrafficEndpoint(
+		ie.NewTrafficEndpointID(1),
+		ie.NewFTEID(0x01, 100, net.ParseIP("10.0.0.1"), nil, 0),
+		ie.NewNetworkInstanceFQDN("internet"),
+		ie.NewUEIPAddress(ueIPAddressV4, "17.0.0.1", "", 0, 0),
+	)
+
+	assert.Equal(t, expected, trafficEndpoint)
+
+	// the PDRs reference the Traffic Endpoint instead of carrying the F-TEID and UE IP address
+	for _, pdr := range []*ie.IE{
+		NewPDRBuilder().WithID(1).WithFARID(1).AddQERID(1).WithTrafficEndpointID(1).MarkAsUplink().BuildPDR(),
+		NewPDRBuilder().WithID(2).WithFARID(2).AddQERID(1).WithTrafficEndpointID(1).MarkAsDownlink().BuildPDR(),
+	} {
+		pdi, err := pdr.PDI()
+		assert.NoError(t, err)
+
+		for _, i := range pdi {
+			assert.NotEqual(t, ie.FTEID, i.Type)
+			assert.NotEqual(t, ie.UEIPAddress, i.Type)
+		}
+
+		assert.Contains(t, pdi, ie.NewTrafficEndpointID(1))
+	}
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package session
+
+import "github.com/wmnsk/go-pfcp/ie"
+
+type urrBuilder struct {
+	method IEMethod
+	urrID  uint32
+
+	volume   bool
+	duration bool
+	event    bool
+
+	reportingTriggers uint16
+
+	// volumeThreshold is the total volume in bytes triggering a usage report. Zero means not set
+	volumeThreshold uint64
+
+	isIDSet                bool
+	isMeasurementMethodSet bool
+	isReportingTriggersSet bool
+}
+
+// NewURRBuilder returns a urrBuilder.
+func NewURRBuilder() *urrBuilder {
+	return &urrBuilder{}
+}
+
+func (b *urrBuilder) WithID(id uint32) *urrBuilder {
+	b.isIDSet = true
+	b.urrID = id
+
+	return b
+}
+
+func (b *urrBuilder) WithMethod(method IEMethod) *urrBuilder {
+	b.method = method
+	return b
+}
+
+// WithMeasurementMethod sets the flags of the Measurement Method IE.
+func (b *urrBuilder) WithMeasurementMethod(volume, duration, event bool) *urrBuilder {
+	b.isMeasurementMethodSet = true
+	b.volume = volume
+	b.duration = duration
+	b.event = event
+
+	return b
+}
+
+// WithReportingTriggers sets the Reporting Triggers IE. If not invoked, the reporting triggers
+// are derived from the measurement methods (e.g. volume threshold for volume measurement).
+func (b *urrBuilder) WithReportingTriggers(triggers uint16) *urrBuilder {
+	b.isReportingTriggersSet = true
+	b.reportingTriggers = triggers
+
+	return b
+}
+
+// WithVolumeThreshold sets the Volume Threshold IE to the total volume, in bytes, triggering a usage report.
+func (b *urrBuilder) WithVolumeThreshold(totalVolume uint64) *urrBuilder {
+	b.volumeThreshold = totalVolume
+	return b
+}
+
+func (b *urrBuilder) validate() {
+	if !b.isIDSet {
+		panic("Tried building URR without setting URR ID")
+	}
+
+	if b.method == Create && !(b.volume || b.duration || b.event) {
+		panic("Tried building URR without setting at least one measurement method")
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+func (b *urrBuilder) getReportingTriggers() uint16 {
+	if b.isReportingTriggersSet {
+		return b.reportingTriggers
+	}
+
+	var triggers uint16
+
+	if b.volume {
+		triggers |= ReportingTriggerVolumeThreshold
+	}
+
+	if b.duration {
+		triggers |= ReportingTriggerTimeThreshold
+	}
+
+	if b.event {
+		triggers |= ReportingTriggerEventThreshold
+	}
+
+	return triggers
+}
+
+// Build returns a Create URR by default.
+// Returns an Update URR or a Remove URR if the corresponding method was provided.
+func (b *urrBuilder) Build() *ie.IE {
+	b.validate()
+
+	if b.method == Delete {
+		return ie.NewRemoveURR(ie.NewURRID(b.urrID))
+	}
+
+	createFunc := ie.NewCreateURR
+	if b.method == Update {
+		createFunc = ie.NewUpdateURR
+	}
+
+	urr := createFunc(
+		ie.NewURRID(b.urrID),
+	)
+
+	if b.isMeasurementMethodSet {
+		urr.Add(ie.NewMeasurementMethod(boolToInt(b.event), boolToInt(b.volume), boolToInt(b.duration)))
+	}
+
+	if b.method == Create || b.isReportingTriggersSet {
+		urr.Add(ie.NewReportingTriggers(b.getReportingTriggers()))
+	}
+
+	if b.volumeThreshold != 0 {
+		urr.Add(ie.NewVolumeThreshold(volumeThresholdTOVOL, b.volumeThreshold, 0, 0))
+	}
+
+	return urr
+}
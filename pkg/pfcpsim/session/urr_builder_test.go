@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package session
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func TestURRBuilderShouldPanic(t *testing.T) {
+	type testCase struct {
+		input       *urrBuilder
+		expected    *urrBuilder
+		description string
+	}
+
+	for _, scenario := range []testCase{
+		{
+			input: NewURRBuilder().
+				WithMethod(Create).
+				WithMeasurementMethod(true, false, false),
+			expected: &urrBuilder{
+				method:                 Create,
+				volume:                 true,
+				isMeasurementMethodSet: true,
+			},
+			description: "Invalid URR: No ID provided",
+		},
+		{
+			input: NewURRBuilder().
+				WithMethod(Create).
+				WithID(1).
+				WithMeasurementMethod(false, false, false),
+			expected: &urrBuilder{
+				method:                 Create,
+				urrID:                  1,
+				isIDSet:                true,
+				isMeasurementMethodSet: true,
+			},
+			description: "Invalid URR: No measurement method provided",
+		},
+	} {
+		t.Run(scenario.description, func(t *testing.T) {
+			assert.Panics(t, func() { scenario.input.Build() })
+			assert.Equal(t, scenario.expected, scenario.input)
+		})
+	}
+}
+
+func TestURRBuilder(t *testing.T) {
+	type testCase struct {
+		input       *urrBuilder
+		expected    *ie.IE
+		description string
+	}
+
+	for _, scenario := range []testCase{
+		{
+			input: NewURRBuilder().
+				WithID(1).
+				WithMethod(Create).
+				WithMeasurementMethod(true, true, false),
+			expected: ie.NewCreateURR(
+				ie.NewURRID(1),
+				ie.NewMeasurementMethod(0, 1, 1),
+				ie.NewReportingTriggers(ReportingTriggerVolumeThreshold|ReportingTriggerTimeThreshold),
+			),
+			description: "Valid Create URR with volume and duration measurement",
+		},
+		{
+			input: NewURRBuilder().
+				WithID(1).
+				WithMethod(Create).
+				WithMeasurementMethod(false, false, true).
+				WithReportingTriggers(ReportingTriggerPeriodic),
+			expected: ie.NewCreateURR(
+				ie.NewURRID(1),
+				ie.NewMeasurementMethod(1, 0, 0),
+				ie.NewReportingTriggers(ReportingTriggerPeriodic),
+			),
+			description: "Valid Create URR with event measurement and custom reporting triggers",
+		},
+		{
+			input: NewURRBuilder().
+				WithID(1).
+				WithMethod(Delete),
+			expected: ie.NewRemoveURR(
+				ie.NewURRID(1),
+			),
+			description: "Valid Remove URR",
+		},
+		{
+			input: NewURRBuilder().
+				WithID(1).
+				WithMethod(Update).
+				WithVolumeThreshold(1000000),
+			expected: ie.NewUpdateURR(
+				ie.NewURRID(1),
+				ie.NewVolumeThreshold(0x01, 1000000, 0, 0),
+			),
+			description: "Valid Update URR raising the volume threshold",
+		},
+	} {
+		t.Run(scenario.description, func(t *testing.T) {
+			assert.NotPanics(t, func() { _ = scenario.input.Build() })
+			assert.Equal(t, scenario.expected, scenario.input.Build())
+		})
+	}
+}
+
+func TestURRBuilderMeasurementMethodEncoding(t *testing.T) {
+	urr := NewURRBuilder().
+		WithID(1).
+		WithMethod(Create).
+		WithMeasurementMethod(true, true, false).
+		Build()
+
+	method, err := urr.MeasurementMethod()
+	assert.NoError(t, err)
+	// DURAT is bit 1, VOLUM bit 2, EVENT bit 3
+	assert.Equal(t, uint8(0x03), method)
+	assert.True(t, urr.HasVOLUM())
+	assert.True(t, urr.HasDURAT())
+	assert.False(t, urr.HasEVENT())
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+package pfcpsim
+
+import (
+	"fmt"
+	"net"
+)
+
+// DefaultMTU is the default size of the buffer used to read PFCP messages.
+const DefaultMTU = 1500
+
+// SocketOptions tunes the UDP socket used on the N4 interface. Zero values keep the system defaults.
+type SocketOptions struct {
+	// MTU is the maximum size of a received PFCP message. Defaults to DefaultMTU
+	MTU int
+	// ReadBufferSize and WriteBufferSize set the size of the socket receive and send buffers
+	ReadBufferSize  int
+	WriteBufferSize int
+	// BindLocalAddress binds the socket to the client local address, so that it is the source address
+	// of sent packets regardless of routing, e.g. to match the IPsec policies protecting a secured interface.
+	BindLocalAddress bool
+}
+
+// SetSocketOptions sets the options applied to the UDP socket by ConnectN4.
+func (c *PFCPClient) SetSocketOptions(opts SocketOptions) {
+	c.socketOptions = opts
+}
+
+// getMTU returns the maximum size of a received PFCP message.
+func (c *PFCPClient) getMTU() int {
+	if c.socketOptions.MTU > 0 {
+		return c.socketOptions.MTU
+	}
+
+	return DefaultMTU
+}
+
+// applySocketOptions applies the socket options to conn.
+func (c *PFCPClient) applySocketOptions(conn *net.UDPConn) error {
+	if c.socketOptions.ReadBufferSize > 0 {
+		if err := conn.SetReadBuffer(c.socketOptions.ReadBufferSize); err != nil {
+			return err
+		}
+	}
+
+	if c.socketOptions.WriteBufferSize > 0 {
+		if err := conn.SetWriteBuffer(c.socketOptions.WriteBufferSize); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getBindAddress returns the address the socket is bound to, or nil to let the system pick the source address.
+func (c *PFCPClient) getBindAddress() (*net.UDPAddr, error) {
+	if !c.socketOptions.BindLocalAddress {
+		return nil, nil
+	}
+
+	ip := net.ParseIP(c.localAddr)
+	if ip == nil {
+		return nil, NewInvalidFormatError("local address", fmt.Errorf("%v", c.localAddr))
+	}
+
+	return &net.UDPAddr{IP: ip}, nil
+}
+
+// SourceAddress returns the source address of the packets sent to the remote peer,
+// or nil if the client is not connected.
+func (c *PFCPClient) SourceAddress() net.IP {
+	if c.conn == nil {
+		return nil
+	}
+
+	return c.conn.LocalAddr().(*net.UDPAddr).IP
+}
+
+// verifySourceAddress returns error if the socket is bound to the local address but sent packets use another one.
+func (c *PFCPClient) verifySourceAddress() error {
+	if !c.socketOptions.BindLocalAddress {
+		return nil
+	}
+
+	if source := c.SourceAddress(); !source.Equal(net.ParseIP(c.localAddr)) {
+		return NewSourceAddressMismatchError(source.String(), c.localAddr)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2022-present Open Networking Foundation
+
+//go:build linux
+// +build linux
+
+package pfcpsim
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// getSocketOption returns the value of an integer socket option of the client connection.
+func getSocketOption(t *testing.T, client *PFCPClient, level int, opt int) int {
+	rawConn, err := client.conn.SyscallConn()
+	require.NoError(t, err)
+
+	var value int
+
+	var sockErr error
+
+	require.NoError(t, rawConn.Control(func(fd uintptr) {
+		value, sockErr = syscall.GetsockoptInt(int(fd), level, opt)
+	}))
+	require.NoError(t, sockErr)
+
+	return value
+}
+
+func TestSocketOptions(t *testing.T) {
+	peer := newFakePeer(t, AcceptAllResponder)
+
+	client := NewPFCPClient("127.0.0.1")
+	client.SetSocketOptions(SocketOptions{
+		ReadBufferSize:  64 * 1024,
+		WriteBufferSize: 32 * 1024,
+	})
+
+	require.NoError(t, client.ConnectN4(peer.address()))
+	t.Cleanup(client.DisconnectN4)
+
+	// Linux doubles the requested size to make room for bookkeeping overhead
+	require.Equal(t, 2*64*1024, getSocketOption(t, client, syscall.SOL_SOCKET, syscall.SO_RCVBUF))
+	require.Equal(t, 2*32*1024, getSocketOption(t, client, syscall.SOL_SOCKET, syscall.SO_SNDBUF))
+
+	require.NoError(t, client.SetupAssociation())
+}
+
+func TestBindLocalAddress(t *testing.T) {
+	peer := newFakePeer(t, AcceptAllResponder)
+
+	// any address of 127.0.0.0/8 is local on Linux: make sure the source is not the one picked by routing
+	client := NewPFCPClient("127.0.0.2")
+	client.SetPFCPResponseTimeout(time.Second)
+	client.SetSocketOptions(SocketOptions{BindLocalAddress: true})
+
+	require.NoError(t, client.ConnectN4(peer.address()))
+	t.Cleanup(client.DisconnectN4)
+
+	require.Equal(t, "127.0.0.2", client.SourceAddress().String())
+	require.NoError(t, client.SetupAssociation())
+
+	// 192.0.2.1 (TEST-NET-1) is not assigned to any local interface
+	client = NewPFCPClient("192.0.2.1")
+	client.SetSocketOptions(SocketOptions{BindLocalAddress: true})
+
+	require.Error(t, client.ConnectN4(peer.address()))
+}